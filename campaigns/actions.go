@@ -0,0 +1,148 @@
+package campaigns
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+)
+
+var (
+	// ErrScheduleParams is returned when a campaign is scheduled
+	// without parameters.
+	ErrScheduleParams = errors.New("campaigns: Schedule parameters are required")
+
+	// ErrScheduleTime is returned when a campaign is scheduled at a
+	// time that is not on the quarter-hour.
+	ErrScheduleTime = errors.New("campaigns: Schedule time must be on the quarter-hour")
+)
+
+// SendType defines the type of a test email.
+type SendType string
+
+// The send type definitions.
+const (
+	SendTypeHTML      SendType = "html"
+	SendTypePlaintext SendType = "plaintext"
+)
+
+// BatchDelivery defines the batch delivery options of a scheduled
+// campaign.
+type BatchDelivery struct {
+	BatchDelay int `json:"batch_delay"`
+	BatchCount int `json:"batch_count"`
+}
+
+// ScheduleParams defines the available parameters that can be used when
+// scheduling a campaign via the Schedule function.
+type ScheduleParams struct {
+	ScheduleTime  time.Time      `json:"schedule_time"`
+	Timewarp      bool           `json:"timewarp,omitempty"`
+	BatchDelivery *BatchDelivery `json:"batch_delivery,omitempty"`
+}
+
+// MarshalJSON handles custom JSON marshalling for the ScheduleParams
+// object.
+// Credit to http://choly.ca/post/go-json-marshalling/
+func (sp *ScheduleParams) MarshalJSON() ([]byte, error) {
+	type alias ScheduleParams
+
+	return json.Marshal(&struct {
+		*alias
+		ScheduleTime string `json:"schedule_time"`
+	}{
+		alias:        (*alias)(sp),
+		ScheduleTime: sp.ScheduleTime.UTC().Format(time.RFC3339),
+	})
+}
+
+// TestParams defines the available parameters that can be used when
+// sending a test email via the Test function.
+type TestParams struct {
+	TestEmails []string `json:"test_emails"`
+	SendType   SendType `json:"send_type"`
+}
+
+// Send sends a campaign.
+func Send(campaignID string) error {
+	path := fmt.Sprintf("campaigns/%s/actions/send", campaignID)
+	return mailchimp.Call("POST", path, nil, nil, nil)
+}
+
+// Schedule schedules a campaign for delivery. The parameters are
+// required, otherwise ErrScheduleParams is returned, and the schedule
+// time must be on the quarter-hour, otherwise ErrScheduleTime is
+// returned.
+func Schedule(campaignID string, params *ScheduleParams) error {
+	if params == nil {
+		return ErrScheduleParams
+	}
+	if params.ScheduleTime.Minute()%15 != 0 || params.ScheduleTime.Second() != 0 ||
+		params.ScheduleTime.Nanosecond() != 0 {
+		return ErrScheduleTime
+	}
+
+	path := fmt.Sprintf("campaigns/%s/actions/schedule", campaignID)
+	return mailchimp.Call("POST", path, nil, params, nil)
+}
+
+// Unschedule unschedules a scheduled campaign.
+func Unschedule(campaignID string) error {
+	path := fmt.Sprintf("campaigns/%s/actions/unschedule", campaignID)
+	return mailchimp.Call("POST", path, nil, nil, nil)
+}
+
+// Test sends a test email of a campaign.
+func Test(campaignID string, params *TestParams) error {
+	path := fmt.Sprintf("campaigns/%s/actions/test", campaignID)
+
+	if params == nil {
+		return mailchimp.Call("POST", path, nil, nil, nil)
+	}
+
+	return mailchimp.Call("POST", path, nil, params, nil)
+}
+
+// Pause pauses an RSS campaign.
+func Pause(campaignID string) error {
+	path := fmt.Sprintf("campaigns/%s/actions/pause", campaignID)
+	return mailchimp.Call("POST", path, nil, nil, nil)
+}
+
+// Resume resumes a paused RSS campaign.
+func Resume(campaignID string) error {
+	path := fmt.Sprintf("campaigns/%s/actions/resume", campaignID)
+	return mailchimp.Call("POST", path, nil, nil, nil)
+}
+
+// Replicate creates a copy of a campaign.
+func Replicate(campaignID string) (*Campaign, error) {
+	res := &Campaign{}
+	path := fmt.Sprintf("campaigns/%s/actions/replicate", campaignID)
+
+	if err := mailchimp.Call("POST", path, nil, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelSend cancels a campaign that is being sent. The campaign moves
+// to StatusCanceling, then to StatusCanceled once the send has stopped.
+func CancelSend(campaignID string) error {
+	path := fmt.Sprintf("campaigns/%s/actions/cancel-send", campaignID)
+	return mailchimp.Call("POST", path, nil, nil, nil)
+}
+
+// Resend creates a copy of a sent campaign that is sent to the members
+// who did not open it.
+func Resend(campaignID string) (*Campaign, error) {
+	res := &Campaign{}
+	path := fmt.Sprintf("campaigns/%s/actions/create-resend", campaignID)
+
+	if err := mailchimp.Call("POST", path, nil, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
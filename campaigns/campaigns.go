@@ -0,0 +1,379 @@
+package campaigns
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+	"github.com/beeker1121/mailchimp-go/query"
+)
+
+// Type defines the type of a campaign.
+type Type string
+
+// The campaign type definitions.
+const (
+	TypeRegular   Type = "regular"
+	TypePlaintext Type = "plaintext"
+	TypeABSplit   Type = "absplit"
+	TypeRSS       Type = "rss"
+	TypeVariate   Type = "variate"
+)
+
+// Status defines the status of a campaign.
+type Status string
+
+// The campaign status definitions.
+const (
+	StatusSave      Status = "save"
+	StatusPaused    Status = "paused"
+	StatusSchedule  Status = "schedule"
+	StatusSending   Status = "sending"
+	StatusSent      Status = "sent"
+	StatusCanceling Status = "canceling"
+	StatusCanceled  Status = "canceled"
+	StatusArchived  Status = "archived"
+)
+
+// SortField defines the field used to sort campaigns.
+type SortField string
+
+// The sort field definitions.
+const (
+	SortFieldCreateTime SortField = "create_time"
+	SortFieldSendTime   SortField = "send_time"
+)
+
+// SortDir defines the direction used to sort results.
+type SortDir string
+
+// The sort direction definitions.
+const (
+	SortDirAsc  SortDir = "ASC"
+	SortDirDesc SortDir = "DESC"
+)
+
+// SegmentOpts defines the segment of a list a campaign is sent to.
+type SegmentOpts struct {
+	SavedSegmentID int                      `json:"saved_segment_id,omitempty"`
+	Match          string                   `json:"match,omitempty"`
+	Conditions     []map[string]interface{} `json:"conditions,omitempty"`
+}
+
+// Recipients defines the list and segment a campaign is sent to.
+type Recipients struct {
+	ListID         string       `json:"list_id"`
+	ListIsActive   bool         `json:"list_is_active,omitempty"`
+	ListName       string       `json:"list_name,omitempty"`
+	SegmentText    string       `json:"segment_text,omitempty"`
+	RecipientCount int          `json:"recipient_count,omitempty"`
+	SegmentOpts    *SegmentOpts `json:"segment_opts,omitempty"`
+}
+
+// Settings defines the settings of a campaign.
+//
+// Fields that hold pointers are only sent when they are non-nil, which
+// allows them to be explicitly set to false. Use the mailchimp.Bool
+// helper to set them.
+type Settings struct {
+	SubjectLine     string `json:"subject_line,omitempty"`
+	PreviewText     string `json:"preview_text,omitempty"`
+	Title           string `json:"title,omitempty"`
+	FromName        string `json:"from_name,omitempty"`
+	ReplyTo         string `json:"reply_to,omitempty"`
+	UseConversation *bool  `json:"use_conversation,omitempty"`
+	ToName          string `json:"to_name,omitempty"`
+	FolderID        string `json:"folder_id,omitempty"`
+	Authenticate    *bool  `json:"authenticate,omitempty"`
+	AutoFooter      *bool  `json:"auto_footer,omitempty"`
+	InlineCSS       *bool  `json:"inline_css,omitempty"`
+	AutoTweet       *bool  `json:"auto_tweet,omitempty"`
+	FBComments      *bool  `json:"fb_comments,omitempty"`
+	Timewarp        *bool  `json:"timewarp,omitempty"`
+	TemplateID      int    `json:"template_id,omitempty"`
+	DragAndDrop     *bool  `json:"drag_and_drop,omitempty"`
+}
+
+// Tracking defines the tracking options of a campaign.
+//
+// Fields that hold pointers are only sent when they are non-nil, which
+// allows them to be explicitly set to false. Use the mailchimp.Bool
+// helper to set them.
+type Tracking struct {
+	Opens           *bool  `json:"opens,omitempty"`
+	HTMLClicks      *bool  `json:"html_clicks,omitempty"`
+	TextClicks      *bool  `json:"text_clicks,omitempty"`
+	GoalTracking    *bool  `json:"goal_tracking,omitempty"`
+	Ecomm360        *bool  `json:"ecomm360,omitempty"`
+	GoogleAnalytics string `json:"google_analytics,omitempty"`
+	Clicktale       string `json:"clicktale,omitempty"`
+}
+
+// SocialCard defines the preview shown when a campaign is shared on
+// social networks.
+type SocialCard struct {
+	ImageURL    string `json:"image_url,omitempty"`
+	Description string `json:"description,omitempty"`
+	Title       string `json:"title,omitempty"`
+}
+
+// ReportSummary defines the summary of the report of a sent campaign.
+type ReportSummary struct {
+	Opens            int     `json:"opens"`
+	UniqueOpens      int     `json:"unique_opens"`
+	OpenRate         float64 `json:"open_rate"`
+	Clicks           int     `json:"clicks"`
+	SubscriberClicks int     `json:"subscriber_clicks"`
+	ClickRate        float64 `json:"click_rate"`
+}
+
+// Campaign defines a campaign.
+type Campaign struct {
+	ID                string         `json:"id"`
+	WebID             int            `json:"web_id,omitempty"`
+	Type              Type           `json:"type"`
+	CreateTime        time.Time      `json:"create_time,omitempty"`
+	ArchiveURL        string         `json:"archive_url,omitempty"`
+	LongArchiveURL    string         `json:"long_archive_url,omitempty"`
+	Status            Status         `json:"status"`
+	EmailsSent        int            `json:"emails_sent,omitempty"`
+	SendTime          time.Time      `json:"send_time,omitempty"`
+	ContentType       string         `json:"content_type,omitempty"`
+	NeedsBlockRefresh bool           `json:"needs_block_refresh,omitempty"`
+	Resendable        bool           `json:"resendable,omitempty"`
+	Recipients        *Recipients    `json:"recipients,omitempty"`
+	Settings          *Settings      `json:"settings,omitempty"`
+	Tracking          *Tracking      `json:"tracking,omitempty"`
+	SocialCard        *SocialCard    `json:"social_card,omitempty"`
+	ReportSummary     *ReportSummary `json:"report_summary,omitempty"`
+}
+
+// UnmarshalJSON handles custom JSON unmarshalling for the Campaign
+// object.
+// Credit to http://choly.ca/post/go-json-marshalling/
+func (c *Campaign) UnmarshalJSON(data []byte) error {
+	var err error
+	type alias Campaign
+
+	aux := &struct {
+		*alias
+		CreateTime string `json:"create_time,omitempty"`
+		SendTime   string `json:"send_time,omitempty"`
+	}{
+		alias: (*alias)(c),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	if aux.CreateTime != "" {
+		if c.CreateTime, err = time.Parse(time.RFC3339, aux.CreateTime); err != nil {
+			return err
+		}
+	}
+	if aux.SendTime != "" {
+		if c.SendTime, err = time.Parse(time.RFC3339, aux.SendTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Campaigns defines a list of campaigns.
+type Campaigns struct {
+	Campaigns  []Campaign `json:"campaigns,omitempty"`
+	TotalItems int        `json:"total_items"`
+}
+
+// NewParams defines the available parameters that can be used when
+// creating a new campaign via the New function.
+type NewParams struct {
+	Type        Type        `json:"type"`
+	Recipients  *Recipients `json:"recipients,omitempty"`
+	Settings    *Settings   `json:"settings,omitempty"`
+	Tracking    *Tracking   `json:"tracking,omitempty"`
+	SocialCard  *SocialCard `json:"social_card,omitempty"`
+	ContentType string      `json:"content_type,omitempty"`
+}
+
+// GetParams defines the available parameters that can be used when
+// getting information about all campaigns via the Get function.
+type GetParams struct {
+	Fields           []string  `url:"fields,omitempty"`
+	ExcludeFields    []string  `url:"exclude_fields,omitempty"`
+	Count            int       `url:"count,omitempty"`
+	Offset           int       `url:"offset,omitempty"`
+	Type             Type      `url:"type,omitempty"`
+	Status           Status    `url:"status,omitempty"`
+	BeforeSendTime   time.Time `url:"before_send_time,omitempty"`
+	SinceSendTime    time.Time `url:"since_send_time,omitempty"`
+	BeforeCreateTime time.Time `url:"before_create_time,omitempty"`
+	SinceCreateTime  time.Time `url:"since_create_time,omitempty"`
+	ListID           string    `url:"list_id,omitempty"`
+	FolderID         string    `url:"folder_id,omitempty"`
+	SortField        SortField `url:"sort_field,omitempty"`
+	SortDir          SortDir   `url:"sort_dir,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetParams object.
+func (gp *GetParams) EncodeQueryString(v interface{}) (string, error) {
+	var beforeSendTime string
+	var sinceSendTime string
+	var beforeCreateTime string
+	var sinceCreateTime string
+
+	if !gp.BeforeSendTime.IsZero() {
+		beforeSendTime = gp.BeforeSendTime.Format(time.RFC3339)
+	}
+	if !gp.SinceSendTime.IsZero() {
+		sinceSendTime = gp.SinceSendTime.Format(time.RFC3339)
+	}
+	if !gp.BeforeCreateTime.IsZero() {
+		beforeCreateTime = gp.BeforeCreateTime.Format(time.RFC3339)
+	}
+	if !gp.SinceCreateTime.IsZero() {
+		sinceCreateTime = gp.SinceCreateTime.Format(time.RFC3339)
+	}
+
+	return query.Encode(struct {
+		Fields           string    `url:"fields,omitempty"`
+		ExcludeFields    string    `url:"exclude_fields,omitempty"`
+		Count            int       `url:"count,omitempty"`
+		Offset           int       `url:"offset,omitempty"`
+		Type             Type      `url:"type,omitempty"`
+		Status           Status    `url:"status,omitempty"`
+		BeforeSendTime   string    `url:"before_send_time,omitempty"`
+		SinceSendTime    string    `url:"since_send_time,omitempty"`
+		BeforeCreateTime string    `url:"before_create_time,omitempty"`
+		SinceCreateTime  string    `url:"since_create_time,omitempty"`
+		ListID           string    `url:"list_id,omitempty"`
+		FolderID         string    `url:"folder_id,omitempty"`
+		SortField        SortField `url:"sort_field,omitempty"`
+		SortDir          SortDir   `url:"sort_dir,omitempty"`
+	}{
+		Fields:           strings.Join(gp.Fields, ","),
+		ExcludeFields:    strings.Join(gp.ExcludeFields, ","),
+		Count:            gp.Count,
+		Offset:           gp.Offset,
+		Type:             gp.Type,
+		Status:           gp.Status,
+		BeforeSendTime:   beforeSendTime,
+		SinceSendTime:    sinceSendTime,
+		BeforeCreateTime: beforeCreateTime,
+		SinceCreateTime:  sinceCreateTime,
+		ListID:           gp.ListID,
+		FolderID:         gp.FolderID,
+		SortField:        gp.SortField,
+		SortDir:          gp.SortDir,
+	})
+}
+
+// GetCampaignParams defines the available parameters that can be used
+// when getting information on a specific campaign via the GetCampaign
+// function.
+type GetCampaignParams struct {
+	Fields        []string `url:"fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetCampaignParams object.
+func (gcp *GetCampaignParams) EncodeQueryString(v interface{}) (string, error) {
+	return query.Encode(struct {
+		Fields        string `url:"fields,omitempty"`
+		ExcludeFields string `url:"exclude_fields,omitempty"`
+	}{
+		Fields:        strings.Join(gcp.Fields, ","),
+		ExcludeFields: strings.Join(gcp.ExcludeFields, ","),
+	})
+}
+
+// UpdateParams defines the available parameters that can be used when
+// updating a campaign via the Update function.
+type UpdateParams struct {
+	Recipients *Recipients `json:"recipients,omitempty"`
+	Settings   *Settings   `json:"settings,omitempty"`
+	Tracking   *Tracking   `json:"tracking,omitempty"`
+	SocialCard *SocialCard `json:"social_card,omitempty"`
+}
+
+// New creates a new campaign.
+func New(params *NewParams) (*Campaign, error) {
+	res := &Campaign{}
+
+	if params == nil {
+		if err := mailchimp.Call("POST", "campaigns", nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("POST", "campaigns", nil, params, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Get retrieves information about all campaigns.
+func Get(params *GetParams) (*Campaigns, error) {
+	res := &Campaigns{}
+
+	if params == nil {
+		if err := mailchimp.Call("GET", "campaigns", nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", "campaigns", params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetCampaign retrieves information about a specific campaign.
+func GetCampaign(campaignID string, params *GetCampaignParams) (*Campaign, error) {
+	res := &Campaign{}
+	path := fmt.Sprintf("campaigns/%s", campaignID)
+
+	if params == nil {
+		if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", path, params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Update updates a campaign.
+func Update(campaignID string, params *UpdateParams) (*Campaign, error) {
+	res := &Campaign{}
+	path := fmt.Sprintf("campaigns/%s", campaignID)
+
+	if params == nil {
+		if err := mailchimp.Call("PATCH", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("PATCH", path, nil, params, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Delete deletes a campaign.
+func Delete(campaignID string) error {
+	path := fmt.Sprintf("campaigns/%s", campaignID)
+	return mailchimp.Call("DELETE", path, nil, nil, nil)
+}
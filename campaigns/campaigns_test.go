@@ -0,0 +1,433 @@
+package campaigns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+)
+
+var timeString = "2020-01-02 23:59:59 +0000 UTC"
+var timeType = reflect.TypeOf(time.Time{})
+
+func TestCampaignUnmarshal(t *testing.T) {
+	data := []byte(`{
+		"id": "abc123",
+		"type": "regular",
+		"status": "save",
+		"create_time": "2020-01-02T23:59:59+00:00",
+		"send_time": ""
+	}`)
+
+	campaign := &Campaign{}
+	if err := json.NewDecoder(bytes.NewBuffer(data)).Decode(campaign); err != nil {
+		t.Error(err)
+	}
+
+	if reflect.TypeOf(campaign.CreateTime) != timeType {
+		t.Errorf("Expected campaign.CreateTime to be of type time.Time, got %v", reflect.TypeOf(campaign.CreateTime))
+	}
+
+	if campaign.CreateTime.String() != timeString {
+		t.Errorf("Expected campaign.CreateTime.String() to equal %s, got %s", timeString, campaign.CreateTime.String())
+	}
+
+	if !campaign.SendTime.IsZero() {
+		t.Errorf("Expected campaign.SendTime to be zero, got %s", campaign.SendTime.String())
+	}
+
+	if campaign.Type != TypeRegular {
+		t.Errorf("Expected campaign.Type to equal %s, got %s", TypeRegular, campaign.Type)
+	}
+	if campaign.Status != StatusSave {
+		t.Errorf("Expected campaign.Status to equal %s, got %s", StatusSave, campaign.Status)
+	}
+}
+
+func TestSettingsMarshal(t *testing.T) {
+	settings := &Settings{
+		SubjectLine: "Newsletter",
+		AutoFooter:  mailchimp.Bool(false),
+	}
+
+	data, err := json.Marshal(settings)
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := `{"subject_line":"Newsletter","auto_footer":false}`
+	if string(data) != expected {
+		t.Errorf("Expected settings to marshal to %s, got %s", expected, string(data))
+	}
+}
+
+func TestGetParamsEncode(t *testing.T) {
+	params := &GetParams{
+		Type:          TypeRegular,
+		Status:        StatusSent,
+		SinceSendTime: time.Date(2020, 1, 2, 23, 59, 59, 0, time.UTC),
+		SortField:     SortFieldSendTime,
+		SortDir:       SortDirDesc,
+	}
+
+	q, err := params.EncodeQueryString(params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := "since_send_time=2020-01-02T23%3A59%3A59Z&sort_dir=DESC&sort_field=send_time&status=sent&type=regular"
+	if q != expected {
+		t.Errorf("Expected query string to equal %s, got %s", expected, q)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	campaign, err := createCampaign()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if err = Delete(campaign.ID); err != nil {
+		t.Error(err)
+	}
+
+	_, err = GetCampaign(campaign.ID, nil)
+
+	if err == nil {
+		t.Error("Expected error to be non-nil")
+	}
+
+	apiErr := err.(*mailchimp.APIError)
+
+	if apiErr.Status != 404 {
+		t.Errorf("Expected err.Status to be 404, got %d", apiErr.Status)
+	}
+}
+
+func TestNew(t *testing.T) {
+	campaign, err := createCampaign()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if campaign.Settings.Title != "mailchimp-go Test Campaign" {
+		t.Errorf("Expected campaign.Settings.Title to equal \"mailchimp-go Test Campaign\", got %s", campaign.Settings.Title)
+	}
+
+	if err = Delete(campaign.ID); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGet(t *testing.T) {
+	campaign, err := createCampaign()
+	if err != nil {
+		t.Error(err)
+	}
+
+	campaigns, err := Get(&GetParams{
+		Status:    StatusSave,
+		SortField: SortFieldCreateTime,
+		SortDir:   SortDirDesc,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(campaigns.Campaigns) == 0 || campaigns.Campaigns[0].ID != campaign.ID {
+		t.Errorf("Expected first campaign to equal %s", campaign.ID)
+	}
+
+	if err = Delete(campaign.ID); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetCampaign(t *testing.T) {
+	campaign, err := createCampaign()
+	if err != nil {
+		t.Error(err)
+	}
+
+	gotCampaign, err := GetCampaign(campaign.ID, nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gotCampaign.ID != campaign.ID {
+		t.Error("Expected gotCampaign.ID to equal campaign.ID")
+	}
+
+	if err = Delete(campaign.ID); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	campaign, err := createCampaign()
+	if err != nil {
+		t.Error(err)
+	}
+
+	updateParams := &UpdateParams{
+		Settings: &Settings{
+			SubjectLine: "Newsletter",
+			Title:       "mailchimp-go Test Campaign 2",
+			FromName:    "John Doe",
+			ReplyTo:     "newsletter@acmecorp.com",
+		},
+	}
+
+	updatedCampaign, err := Update(campaign.ID, updateParams)
+	if err != nil {
+		t.Error(err)
+	}
+
+	gotCampaign, err := GetCampaign(campaign.ID, nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gotCampaign.Settings.Title != "mailchimp-go Test Campaign 2" {
+		t.Errorf("Expected gotCampaign.Settings.Title to equal \"mailchimp-go Test Campaign 2\", got %s", gotCampaign.Settings.Title)
+	}
+	if gotCampaign.Settings.Title != updatedCampaign.Settings.Title {
+		t.Error("Expected gotCampaign.Settings.Title to equal updatedCampaign.Settings.Title")
+	}
+
+	if err = Delete(campaign.ID); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestScheduleParamsMarshal(t *testing.T) {
+	params := &ScheduleParams{
+		ScheduleTime: time.Date(2020, 1, 2, 23, 45, 0, 0, time.UTC),
+		Timewarp:     true,
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := `{"timewarp":true,"schedule_time":"2020-01-02T23:45:00Z"}`
+	if string(data) != expected {
+		t.Errorf("Expected params to marshal to %s, got %s", expected, string(data))
+	}
+}
+
+func TestScheduleInvalidTime(t *testing.T) {
+	params := &ScheduleParams{
+		ScheduleTime: time.Date(2020, 1, 2, 23, 50, 0, 0, time.UTC),
+	}
+
+	if err := Schedule("abc123", params); err != ErrScheduleTime {
+		t.Errorf("Expected err to equal ErrScheduleTime, got %v", err)
+	}
+}
+
+func TestScheduleNoParams(t *testing.T) {
+	if err := Schedule("abc123", nil); err != ErrScheduleParams {
+		t.Errorf("Expected err to equal ErrScheduleParams, got %v", err)
+	}
+}
+
+func TestReplicate(t *testing.T) {
+	campaign, err := createCampaign()
+	if err != nil {
+		t.Error(err)
+	}
+
+	replica, err := Replicate(campaign.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if replica.ID == campaign.ID {
+		t.Error("Expected replica.ID to not equal campaign.ID")
+	}
+
+	if err = Delete(replica.ID); err != nil {
+		t.Error(err)
+	}
+	if err = Delete(campaign.ID); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetContentParamsMarshal(t *testing.T) {
+	params := &SetContentParams{
+		Template: &ContentTemplate{
+			ID: 123,
+			Sections: map[string]string{
+				"body": "<p>Hello</p>",
+			},
+		},
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := `{"template":{"id":123,"sections":{"body":"\u003cp\u003eHello\u003c/p\u003e"}}}`
+	if string(data) != expected {
+		t.Errorf("Expected params to marshal to %s, got %s", expected, string(data))
+	}
+}
+
+func TestSetContent(t *testing.T) {
+	campaign, err := createCampaign()
+	if err != nil {
+		t.Error(err)
+	}
+
+	params := &SetContentParams{
+		HTML: "<html><body><p>Hello</p></body></html>",
+	}
+
+	if _, err = SetContent(campaign.ID, params); err != nil {
+		t.Error(err)
+	}
+
+	content, err := GetContent(campaign.ID, nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !strings.Contains(content.HTML, "<p>Hello</p>") {
+		t.Errorf("Expected content.HTML to contain \"<p>Hello</p>\", got %s", content.HTML)
+	}
+
+	if err = Delete(campaign.ID); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSendChecklistIsReady(t *testing.T) {
+	data := []byte(`{
+		"is_ready": true,
+		"items": [
+			{"type": "success", "id": 1, "heading": "List", "details": "Ok"},
+			{"type": "error", "id": 2, "heading": "Subject", "details": "Missing"}
+		]
+	}`)
+
+	checklist := &SendChecklist{}
+	if err := json.NewDecoder(bytes.NewBuffer(data)).Decode(checklist); err != nil {
+		t.Error(err)
+	}
+
+	if checklist.IsReady() {
+		t.Error("Expected checklist.IsReady() to be false")
+	}
+
+	checklist.Items = checklist.Items[:1]
+	if !checklist.IsReady() {
+		t.Error("Expected checklist.IsReady() to be true")
+	}
+
+	checklist.Ready = false
+	if checklist.IsReady() {
+		t.Error("Expected checklist.IsReady() to be false")
+	}
+}
+
+func TestFeedbackUnmarshal(t *testing.T) {
+	data := []byte(`{
+		"feedback_id": 1,
+		"block_id": 2,
+		"message": "Looks good",
+		"created_at": "2020-01-02T23:59:59+00:00",
+		"updated_at": "2020-01-02T23:59:59+00:00"
+	}`)
+
+	feedback := &Feedback{}
+	if err := json.NewDecoder(bytes.NewBuffer(data)).Decode(feedback); err != nil {
+		t.Error(err)
+	}
+
+	if feedback.CreatedAt.String() != timeString {
+		t.Errorf("Expected feedback.CreatedAt.String() to equal %s, got %s", timeString, feedback.CreatedAt.String())
+	}
+	if feedback.UpdatedAt.String() != timeString {
+		t.Errorf("Expected feedback.UpdatedAt.String() to equal %s, got %s", timeString, feedback.UpdatedAt.String())
+	}
+
+	if feedback.BlockID != 2 {
+		t.Errorf("Expected feedback.BlockID to equal 2, got %d", feedback.BlockID)
+	}
+}
+
+func TestFeedback(t *testing.T) {
+	campaign, err := createCampaign()
+	if err != nil {
+		t.Error(err)
+	}
+
+	feedback, err := NewFeedback(campaign.ID, &NewFeedbackParams{
+		Message: "Looks good",
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	updated, err := UpdateFeedback(campaign.ID, feedback.FeedbackID, &UpdateFeedbackParams{
+		IsComplete: mailchimp.Bool(true),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !updated.IsComplete {
+		t.Error("Expected updated.IsComplete to be true")
+	}
+
+	all, err := GetFeedback(campaign.ID, nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if all.TotalItems != 1 {
+		t.Errorf("Expected all.TotalItems to equal 1, got %d", all.TotalItems)
+	}
+
+	if err = DeleteFeedback(campaign.ID, feedback.FeedbackID); err != nil {
+		t.Error(err)
+	}
+
+	if err = Delete(campaign.ID); err != nil {
+		t.Error(err)
+	}
+}
+
+func createCampaign() (*Campaign, error) {
+	params := &NewParams{
+		Type: TypeRegular,
+		Settings: &Settings{
+			SubjectLine: "Newsletter",
+			Title:       "mailchimp-go Test Campaign",
+			FromName:    "John Doe",
+			ReplyTo:     "newsletter@acmecorp.com",
+		},
+	}
+
+	return New(params)
+}
+
+func TestMain(m *testing.M) {
+	if err := mailchimp.SetKey(os.Getenv("MAILCHIMP_API_KEY")); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	os.Exit(code)
+}
@@ -0,0 +1,59 @@
+package campaigns
+
+import (
+	"fmt"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+)
+
+// ChecklistItemType defines the type of a send checklist item.
+type ChecklistItemType string
+
+// The checklist item type definitions.
+const (
+	ChecklistItemSuccess ChecklistItemType = "success"
+	ChecklistItemWarning ChecklistItemType = "warning"
+	ChecklistItemError   ChecklistItemType = "error"
+)
+
+// ChecklistItem defines an item of a send checklist.
+type ChecklistItem struct {
+	Type    ChecklistItemType `json:"type"`
+	ID      int               `json:"id"`
+	Heading string            `json:"heading"`
+	Details string            `json:"details"`
+}
+
+// SendChecklist defines the send checklist of a campaign.
+type SendChecklist struct {
+	Ready bool            `json:"is_ready"`
+	Items []ChecklistItem `json:"items,omitempty"`
+}
+
+// IsReady returns whether the campaign is ready to be sent. It returns
+// false if MailChimp reports the campaign is not ready or if any item
+// of the checklist is an error.
+func (sc *SendChecklist) IsReady() bool {
+	if !sc.Ready {
+		return false
+	}
+
+	for _, item := range sc.Items {
+		if item.Type == ChecklistItemError {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GetSendChecklist retrieves the send checklist of a campaign.
+func GetSendChecklist(campaignID string) (*SendChecklist, error) {
+	res := &SendChecklist{}
+	path := fmt.Sprintf("campaigns/%s/send-checklist", campaignID)
+
+	if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,112 @@
+package campaigns
+
+import (
+	"fmt"
+	"strings"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+	"github.com/beeker1121/mailchimp-go/query"
+)
+
+// ArchiveType defines the type of an archive file used as campaign
+// content.
+type ArchiveType string
+
+// The archive type definitions.
+const (
+	ArchiveTypeZip    ArchiveType = "zip"
+	ArchiveTypeTarGz  ArchiveType = "tar.gz"
+	ArchiveTypeTarBz2 ArchiveType = "tar.bz2"
+	ArchiveTypeTar    ArchiveType = "tar"
+	ArchiveTypeTgz    ArchiveType = "tgz"
+	ArchiveTypeTbz    ArchiveType = "tbz"
+)
+
+// Content defines the content of a campaign.
+type Content struct {
+	PlainText   string `json:"plain_text"`
+	HTML        string `json:"html"`
+	ArchiveHTML string `json:"archive_html,omitempty"`
+}
+
+// ContentTemplate defines the template used as campaign content, along
+// with the content of its editable sections.
+type ContentTemplate struct {
+	ID       int               `json:"id"`
+	Sections map[string]string `json:"sections,omitempty"`
+}
+
+// ContentArchive defines an archive file used as campaign content. The
+// archive content must be base64 encoded.
+type ContentArchive struct {
+	ArchiveContent string      `json:"archive_content"`
+	ArchiveType    ArchiveType `json:"archive_type,omitempty"`
+}
+
+// GetContentParams defines the available parameters that can be used
+// when getting the content of a campaign via the GetContent function.
+type GetContentParams struct {
+	Fields        []string `url:"fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetContentParams object.
+func (gcp *GetContentParams) EncodeQueryString(v interface{}) (string, error) {
+	return query.Encode(struct {
+		Fields        string `url:"fields,omitempty"`
+		ExcludeFields string `url:"exclude_fields,omitempty"`
+	}{
+		Fields:        strings.Join(gcp.Fields, ","),
+		ExcludeFields: strings.Join(gcp.ExcludeFields, ","),
+	})
+}
+
+// SetContentParams defines the available parameters that can be used
+// when setting the content of a campaign via the SetContent function.
+//
+// Only one source of content should be set: raw HTML and plain text, a
+// URL to import, an archive or a template.
+type SetContentParams struct {
+	PlainText string           `json:"plain_text,omitempty"`
+	HTML      string           `json:"html,omitempty"`
+	URL       string           `json:"url,omitempty"`
+	Template  *ContentTemplate `json:"template,omitempty"`
+	Archive   *ContentArchive  `json:"archive,omitempty"`
+}
+
+// GetContent retrieves the content of a campaign.
+func GetContent(campaignID string, params *GetContentParams) (*Content, error) {
+	res := &Content{}
+	path := fmt.Sprintf("campaigns/%s/content", campaignID)
+
+	if params == nil {
+		if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", path, params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SetContent sets the content of a campaign.
+func SetContent(campaignID string, params *SetContentParams) (*Content, error) {
+	res := &Content{}
+	path := fmt.Sprintf("campaigns/%s/content", campaignID)
+
+	if params == nil {
+		if err := mailchimp.Call("PUT", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("PUT", path, nil, params, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
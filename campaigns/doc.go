@@ -0,0 +1,4 @@
+// Package campaigns implements the Campaigns resource of the MailChimp API v3.
+//
+// Reference: http://developer.mailchimp.com/documentation/mailchimp/reference/campaigns/
+package campaigns
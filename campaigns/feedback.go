@@ -0,0 +1,202 @@
+package campaigns
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+	"github.com/beeker1121/mailchimp-go/query"
+)
+
+// Feedback defines a feedback comment on a campaign.
+type Feedback struct {
+	FeedbackID int       `json:"feedback_id"`
+	ParentID   int       `json:"parent_id,omitempty"`
+	BlockID    int       `json:"block_id,omitempty"`
+	Message    string    `json:"message"`
+	IsComplete bool      `json:"is_complete"`
+	CreatedBy  string    `json:"created_by,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at,omitempty"`
+	Source     string    `json:"source,omitempty"`
+	CampaignID string    `json:"campaign_id,omitempty"`
+}
+
+// UnmarshalJSON handles custom JSON unmarshalling for the Feedback
+// object.
+// Credit to http://choly.ca/post/go-json-marshalling/
+func (f *Feedback) UnmarshalJSON(data []byte) error {
+	var err error
+	type alias Feedback
+
+	aux := &struct {
+		*alias
+		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at,omitempty"`
+	}{
+		alias: (*alias)(f),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	if aux.CreatedAt != "" {
+		if f.CreatedAt, err = time.Parse(time.RFC3339, aux.CreatedAt); err != nil {
+			return err
+		}
+	}
+	if aux.UpdatedAt != "" {
+		if f.UpdatedAt, err = time.Parse(time.RFC3339, aux.UpdatedAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CampaignFeedback defines a list of feedback comments on a campaign.
+type CampaignFeedback struct {
+	Feedback   []Feedback `json:"feedback,omitempty"`
+	CampaignID string     `json:"campaign_id"`
+	TotalItems int        `json:"total_items"`
+}
+
+// NewFeedbackParams defines the available parameters that can be used
+// when adding feedback to a campaign via the NewFeedback function.
+type NewFeedbackParams struct {
+	Message    string `json:"message"`
+	BlockID    int    `json:"block_id,omitempty"`
+	IsComplete bool   `json:"is_complete,omitempty"`
+}
+
+// GetFeedbackParams defines the available parameters that can be used
+// when getting the feedback of a campaign via the GetFeedback function.
+type GetFeedbackParams struct {
+	Fields        []string `url:"fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetFeedbackParams object.
+func (gfp *GetFeedbackParams) EncodeQueryString(v interface{}) (string, error) {
+	return query.Encode(struct {
+		Fields        string `url:"fields,omitempty"`
+		ExcludeFields string `url:"exclude_fields,omitempty"`
+	}{
+		Fields:        strings.Join(gfp.Fields, ","),
+		ExcludeFields: strings.Join(gfp.ExcludeFields, ","),
+	})
+}
+
+// GetFeedbackMessageParams defines the available parameters that can be
+// used when getting a specific feedback message via the
+// GetFeedbackMessage function.
+type GetFeedbackMessageParams struct {
+	Fields        []string `url:"fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetFeedbackMessageParams object.
+func (gfmp *GetFeedbackMessageParams) EncodeQueryString(v interface{}) (string, error) {
+	return query.Encode(struct {
+		Fields        string `url:"fields,omitempty"`
+		ExcludeFields string `url:"exclude_fields,omitempty"`
+	}{
+		Fields:        strings.Join(gfmp.Fields, ","),
+		ExcludeFields: strings.Join(gfmp.ExcludeFields, ","),
+	})
+}
+
+// UpdateFeedbackParams defines the available parameters that can be
+// used when updating a feedback message via the UpdateFeedback function.
+//
+// IsComplete is only sent when it is non-nil, which allows it to be
+// explicitly set to false. Use the mailchimp.Bool helper to set it.
+type UpdateFeedbackParams struct {
+	Message    string `json:"message,omitempty"`
+	BlockID    int    `json:"block_id,omitempty"`
+	IsComplete *bool  `json:"is_complete,omitempty"`
+}
+
+// NewFeedback adds a new feedback message to a campaign.
+func NewFeedback(campaignID string, params *NewFeedbackParams) (*Feedback, error) {
+	res := &Feedback{}
+	path := fmt.Sprintf("campaigns/%s/feedback", campaignID)
+
+	if params == nil {
+		if err := mailchimp.Call("POST", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("POST", path, nil, params, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetFeedback retrieves the feedback of a campaign.
+func GetFeedback(campaignID string, params *GetFeedbackParams) (*CampaignFeedback, error) {
+	res := &CampaignFeedback{}
+	path := fmt.Sprintf("campaigns/%s/feedback", campaignID)
+
+	if params == nil {
+		if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", path, params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetFeedbackMessage retrieves a specific feedback message of a
+// campaign.
+func GetFeedbackMessage(campaignID string, feedbackID int, params *GetFeedbackMessageParams) (*Feedback, error) {
+	res := &Feedback{}
+	path := fmt.Sprintf("campaigns/%s/feedback/%d", campaignID, feedbackID)
+
+	if params == nil {
+		if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", path, params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UpdateFeedback updates a feedback message of a campaign.
+func UpdateFeedback(campaignID string, feedbackID int, params *UpdateFeedbackParams) (*Feedback, error) {
+	res := &Feedback{}
+	path := fmt.Sprintf("campaigns/%s/feedback/%d", campaignID, feedbackID)
+
+	if params == nil {
+		if err := mailchimp.Call("PATCH", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("PATCH", path, nil, params, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteFeedback deletes a feedback message of a campaign.
+func DeleteFeedback(campaignID string, feedbackID int) error {
+	path := fmt.Sprintf("campaigns/%s/feedback/%d", campaignID, feedbackID)
+	return mailchimp.Call("DELETE", path, nil, nil, nil)
+}
@@ -0,0 +1,139 @@
+package lists
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+	"github.com/beeker1121/mailchimp-go/query"
+)
+
+// AbuseReport defines a spam complaint made by a member of a list.
+type AbuseReport struct {
+	ID           int                    `json:"id"`
+	CampaignID   string                 `json:"campaign_id"`
+	ListID       string                 `json:"list_id"`
+	EmailID      string                 `json:"email_id"`
+	EmailAddress string                 `json:"email_address"`
+	MergeFields  map[string]interface{} `json:"merge_fields,omitempty"`
+	VIP          bool                   `json:"vip,omitempty"`
+	Date         time.Time              `json:"date"`
+}
+
+// UnmarshalJSON handles custom JSON unmarshalling for the AbuseReport
+// object.
+// Credit to http://choly.ca/post/go-json-marshalling/
+func (ar *AbuseReport) UnmarshalJSON(data []byte) error {
+	var err error
+	type alias AbuseReport
+
+	aux := &struct {
+		*alias
+		Date string `json:"date"`
+	}{
+		alias: (*alias)(ar),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	if aux.Date != "" {
+		if ar.Date, err = time.Parse(time.RFC3339, aux.Date); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AbuseReports defines a list of abuse reports.
+type AbuseReports struct {
+	AbuseReports []AbuseReport `json:"abuse_reports,omitempty"`
+	ListID       string        `json:"list_id"`
+	TotalItems   int           `json:"total_items"`
+}
+
+// GetAbuseReportsParams defines the available parameters that can be
+// used when getting the abuse reports of a list via the
+// GetAbuseReports function.
+type GetAbuseReportsParams struct {
+	Fields        []string `url:"fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+	Count         int      `url:"count,omitempty"`
+	Offset        int      `url:"offset,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetAbuseReportsParams object.
+func (garp *GetAbuseReportsParams) EncodeQueryString(v interface{}) (string, error) {
+	return query.Encode(struct {
+		Fields        string `url:"fields,omitempty"`
+		ExcludeFields string `url:"exclude_fields,omitempty"`
+		Count         int    `url:"count,omitempty"`
+		Offset        int    `url:"offset,omitempty"`
+	}{
+		Fields:        strings.Join(garp.Fields, ","),
+		ExcludeFields: strings.Join(garp.ExcludeFields, ","),
+		Count:         garp.Count,
+		Offset:        garp.Offset,
+	})
+}
+
+// GetAbuseReportParams defines the available parameters that can be
+// used when getting a specific abuse report via the GetAbuseReport
+// function.
+type GetAbuseReportParams struct {
+	Fields        []string `url:"fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetAbuseReportParams object.
+func (garp *GetAbuseReportParams) EncodeQueryString(v interface{}) (string, error) {
+	return query.Encode(struct {
+		Fields        string `url:"fields,omitempty"`
+		ExcludeFields string `url:"exclude_fields,omitempty"`
+	}{
+		Fields:        strings.Join(garp.Fields, ","),
+		ExcludeFields: strings.Join(garp.ExcludeFields, ","),
+	})
+}
+
+// GetAbuseReports retrieves the abuse reports of a list.
+func GetAbuseReports(listID string, params *GetAbuseReportsParams) (*AbuseReports, error) {
+	res := &AbuseReports{}
+	path := fmt.Sprintf("lists/%s/abuse-reports", listID)
+
+	if params == nil {
+		if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", path, params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAbuseReport retrieves a specific abuse report of a list.
+func GetAbuseReport(listID string, reportID int, params *GetAbuseReportParams) (*AbuseReport, error) {
+	res := &AbuseReport{}
+	path := fmt.Sprintf("lists/%s/abuse-reports/%d", listID, reportID)
+
+	if params == nil {
+		if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", path, params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
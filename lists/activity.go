@@ -0,0 +1,205 @@
+package lists
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+	"github.com/beeker1121/mailchimp-go/query"
+)
+
+// dayFormat is the layout of the days used by the list activity.
+const dayFormat = "2006-01-02"
+
+// Activity defines the activity of a list during a single day.
+type Activity struct {
+	Day             time.Time `json:"day"`
+	EmailsSent      int       `json:"emails_sent"`
+	UniqueOpens     int       `json:"unique_opens"`
+	RecipientClicks int       `json:"recipient_clicks"`
+	HardBounce      int       `json:"hard_bounce"`
+	SoftBounce      int       `json:"soft_bounce"`
+	Subs            int       `json:"subs"`
+	Unsubs          int       `json:"unsubs"`
+	OtherAdds       int       `json:"other_adds"`
+	OtherRemoves    int       `json:"other_removes"`
+}
+
+// UnmarshalJSON handles custom JSON unmarshalling for the Activity
+// object.
+// Credit to http://choly.ca/post/go-json-marshalling/
+func (a *Activity) UnmarshalJSON(data []byte) error {
+	var err error
+	type alias Activity
+
+	aux := &struct {
+		*alias
+		Day string `json:"day"`
+	}{
+		alias: (*alias)(a),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	if aux.Day != "" {
+		if a.Day, err = time.Parse(dayFormat, aux.Day); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListActivity defines the recent daily activity of a list.
+type ListActivity struct {
+	Activity   []Activity `json:"activity,omitempty"`
+	ListID     string     `json:"list_id"`
+	TotalItems int        `json:"total_items"`
+}
+
+// Client defines an email client used by the members of a list.
+type Client struct {
+	Client  string `json:"client"`
+	Members int    `json:"members"`
+}
+
+// ListClients defines the email clients used by the members of a list.
+type ListClients struct {
+	Clients    []Client `json:"clients,omitempty"`
+	ListID     string   `json:"list_id"`
+	TotalItems int      `json:"total_items"`
+}
+
+// Location defines a country the members of a list are located in.
+type Location struct {
+	Country string  `json:"country"`
+	CC      string  `json:"cc"`
+	Percent float64 `json:"percent"`
+	Total   int     `json:"total"`
+}
+
+// ListLocations defines the countries the members of a list are
+// located in.
+type ListLocations struct {
+	Locations  []Location `json:"locations,omitempty"`
+	ListID     string     `json:"list_id"`
+	TotalItems int        `json:"total_items"`
+}
+
+// GetActivityParams defines the available parameters that can be used
+// when getting the activity of a list via the GetActivity function.
+type GetActivityParams struct {
+	Fields        []string `url:"fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetActivityParams object.
+func (gap *GetActivityParams) EncodeQueryString(v interface{}) (string, error) {
+	return query.Encode(struct {
+		Fields        string `url:"fields,omitempty"`
+		ExcludeFields string `url:"exclude_fields,omitempty"`
+	}{
+		Fields:        strings.Join(gap.Fields, ","),
+		ExcludeFields: strings.Join(gap.ExcludeFields, ","),
+	})
+}
+
+// GetClientsParams defines the available parameters that can be used
+// when getting the email clients of a list via the GetClients function.
+type GetClientsParams struct {
+	Fields        []string `url:"fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetClientsParams object.
+func (gcp *GetClientsParams) EncodeQueryString(v interface{}) (string, error) {
+	return query.Encode(struct {
+		Fields        string `url:"fields,omitempty"`
+		ExcludeFields string `url:"exclude_fields,omitempty"`
+	}{
+		Fields:        strings.Join(gcp.Fields, ","),
+		ExcludeFields: strings.Join(gcp.ExcludeFields, ","),
+	})
+}
+
+// GetLocationsParams defines the available parameters that can be used
+// when getting the locations of a list via the GetLocations function.
+type GetLocationsParams struct {
+	Fields        []string `url:"fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetLocationsParams object.
+func (glp *GetLocationsParams) EncodeQueryString(v interface{}) (string, error) {
+	return query.Encode(struct {
+		Fields        string `url:"fields,omitempty"`
+		ExcludeFields string `url:"exclude_fields,omitempty"`
+	}{
+		Fields:        strings.Join(glp.Fields, ","),
+		ExcludeFields: strings.Join(glp.ExcludeFields, ","),
+	})
+}
+
+// GetActivity retrieves the daily activity of a list for up to the
+// last 180 days.
+func GetActivity(listID string, params *GetActivityParams) (*ListActivity, error) {
+	res := &ListActivity{}
+	path := fmt.Sprintf("lists/%s/activity", listID)
+
+	if params == nil {
+		if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", path, params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetClients retrieves the top email clients used by the members of a
+// list.
+func GetClients(listID string, params *GetClientsParams) (*ListClients, error) {
+	res := &ListClients{}
+	path := fmt.Sprintf("lists/%s/clients", listID)
+
+	if params == nil {
+		if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", path, params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetLocations retrieves the countries the members of a list are
+// located in, based on their IP addresses.
+func GetLocations(listID string, params *GetLocationsParams) (*ListLocations, error) {
+	res := &ListLocations{}
+	path := fmt.Sprintf("lists/%s/locations", listID)
+
+	if params == nil {
+		if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", path, params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
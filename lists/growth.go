@@ -0,0 +1,157 @@
+package lists
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+	"github.com/beeker1121/mailchimp-go/query"
+)
+
+// monthFormat is the layout of the months used by the growth history.
+const monthFormat = "2006-01"
+
+// GrowthHistory defines the growth of a list during a single month.
+type GrowthHistory struct {
+	ListID        string    `json:"list_id"`
+	Month         time.Time `json:"month"`
+	Existing      int       `json:"existing"`
+	Imports       int       `json:"imports"`
+	Optins        int       `json:"optins"`
+	Subscribed    int       `json:"subscribed"`
+	Unsubscribed  int       `json:"unsubscribed"`
+	Reconfirm     int       `json:"reconfirm"`
+	Cleaned       int       `json:"cleaned"`
+	Pending       int       `json:"pending"`
+	Deleted       int       `json:"deleted"`
+	Transactional int       `json:"transactional"`
+}
+
+// UnmarshalJSON handles custom JSON unmarshalling for the GrowthHistory
+// object.
+// Credit to http://choly.ca/post/go-json-marshalling/
+func (gh *GrowthHistory) UnmarshalJSON(data []byte) error {
+	var err error
+	type alias GrowthHistory
+
+	aux := &struct {
+		*alias
+		Month string `json:"month"`
+	}{
+		alias: (*alias)(gh),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	if aux.Month != "" {
+		if gh.Month, err = time.Parse(monthFormat, aux.Month); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GrowthHistories defines the monthly growth history of a list.
+type GrowthHistories struct {
+	History    []GrowthHistory `json:"history,omitempty"`
+	ListID     string          `json:"list_id"`
+	TotalItems int             `json:"total_items"`
+}
+
+// GetGrowthHistoryParams defines the available parameters that can be
+// used when getting the growth history of a list via the
+// GetGrowthHistory function. Months are sorted by date.
+type GetGrowthHistoryParams struct {
+	Fields        []string `url:"fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+	Count         int      `url:"count,omitempty"`
+	Offset        int      `url:"offset,omitempty"`
+	SortDir       SortDir  `url:"sort_dir,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetGrowthHistoryParams object.
+func (gghp *GetGrowthHistoryParams) EncodeQueryString(v interface{}) (string, error) {
+	var sortField string
+	if gghp.SortDir != "" {
+		sortField = "month"
+	}
+
+	return query.Encode(struct {
+		Fields        string  `url:"fields,omitempty"`
+		ExcludeFields string  `url:"exclude_fields,omitempty"`
+		Count         int     `url:"count,omitempty"`
+		Offset        int     `url:"offset,omitempty"`
+		SortField     string  `url:"sort_field,omitempty"`
+		SortDir       SortDir `url:"sort_dir,omitempty"`
+	}{
+		Fields:        strings.Join(gghp.Fields, ","),
+		ExcludeFields: strings.Join(gghp.ExcludeFields, ","),
+		Count:         gghp.Count,
+		Offset:        gghp.Offset,
+		SortField:     sortField,
+		SortDir:       gghp.SortDir,
+	})
+}
+
+// GetGrowthHistoryMonthParams defines the available parameters that
+// can be used when getting the growth of a list for a specific month
+// via the GetGrowthHistoryMonth function.
+type GetGrowthHistoryMonthParams struct {
+	Fields        []string `url:"fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetGrowthHistoryMonthParams object.
+func (gghmp *GetGrowthHistoryMonthParams) EncodeQueryString(v interface{}) (string, error) {
+	return query.Encode(struct {
+		Fields        string `url:"fields,omitempty"`
+		ExcludeFields string `url:"exclude_fields,omitempty"`
+	}{
+		Fields:        strings.Join(gghmp.Fields, ","),
+		ExcludeFields: strings.Join(gghmp.ExcludeFields, ","),
+	})
+}
+
+// GetGrowthHistory retrieves the monthly growth history of a list.
+func GetGrowthHistory(listID string, params *GetGrowthHistoryParams) (*GrowthHistories, error) {
+	res := &GrowthHistories{}
+	path := fmt.Sprintf("lists/%s/growth-history", listID)
+
+	if params == nil {
+		if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", path, params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetGrowthHistoryMonth retrieves the growth of a list for the month
+// of the given time.
+func GetGrowthHistoryMonth(listID string, month time.Time, params *GetGrowthHistoryMonthParams) (*GrowthHistory, error) {
+	res := &GrowthHistory{}
+	path := fmt.Sprintf("lists/%s/growth-history/%s", listID, month.Format(monthFormat))
+
+	if params == nil {
+		if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", path, params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
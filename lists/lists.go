@@ -37,7 +37,7 @@ type Visibility string
 // The visibility definitions.
 const (
 	VisibilityPublic  Visibility = "pub"
-	VisibilityPrivate            = "prv"
+	VisibilityPrivate Visibility = "prv"
 )
 
 // Stats defines statistics for a list.
@@ -58,6 +58,7 @@ type Stats struct {
 	ClickRate                 float32   `json:"click_rate,omitempty"`
 	LastSubDate               time.Time `json:"last_sub_date,omitempty"`
 	LastUnsubDate             time.Time `json:"last_unsub_date,omitempty"`
+	TotalContacts             uint      `json:"total_contacts,omitempty"`
 }
 
 // UnmarshalJSON handles custom JSON unmarshalling for the Stats object.
@@ -165,8 +166,29 @@ type NewParams struct {
 	Visibility          Visibility        `json:"visibility"`
 }
 
+// SortField defines the field used to sort lists.
+type SortField string
+
+// The sort field definitions.
+const (
+	SortFieldDateCreated SortField = "date_created"
+)
+
+// SortDir defines the direction used to sort results.
+type SortDir string
+
+// The sort direction definitions.
+const (
+	SortDirAsc  SortDir = "ASC"
+	SortDirDesc SortDir = "DESC"
+)
+
 // GetParams defines the available parameters that can be used when
 // getting information about all lists via the Get function.
+//
+// HasEcommerceStore is only sent when it is non-nil, which allows
+// filtering on lists without an ecommerce store. Use the mailchimp.Bool
+// helper to set it.
 type GetParams struct {
 	Fields                 []string  `url:"fields,omitempty"`
 	ExcludeFields          []string  `url:"exclude_fields,omitempty"`
@@ -177,6 +199,10 @@ type GetParams struct {
 	BeforeCampaignLastSent time.Time `url:"before_campaign_last_sent,omitempty"`
 	SinceCampaignLastSent  time.Time `url:"since_campaign_last_sent,omitempty"`
 	Email                  string    `url:"email,omitempty"`
+	SortField              SortField `url:"sort_field,omitempty"`
+	SortDir                SortDir   `url:"sort_dir,omitempty"`
+	HasEcommerceStore      *bool     `url:"has_ecommerce_store,omitempty"`
+	IncludeTotalContacts   bool      `url:"include_total_contacts,omitempty"`
 }
 
 // EncodeQueryString handles custom query string encoding for the
@@ -201,15 +227,19 @@ func (gp *GetParams) EncodeQueryString(v interface{}) (string, error) {
 	}
 
 	return query.Encode(struct {
-		Fields                 string `url:"fields,omitempty"`
-		ExcludeFields          string `url:"exclude_fields,omitempty"`
-		Count                  int    `url:"count,omitempty"`
-		Offset                 int    `url:"offset,omitempty"`
-		BeforeDateCreated      string `url:"before_date_created,omitempty"`
-		SinceDateCreated       string `url:"since_date_created,omitempty"`
-		BeforeCampaignLastSent string `url:"before_campaign_last_sent,omitempty"`
-		SinceCampaignLastSent  string `url:"since_campaign_last_sent,omitempty"`
-		Email                  string `url:"email,omitempty"`
+		Fields                 string    `url:"fields,omitempty"`
+		ExcludeFields          string    `url:"exclude_fields,omitempty"`
+		Count                  int       `url:"count,omitempty"`
+		Offset                 int       `url:"offset,omitempty"`
+		BeforeDateCreated      string    `url:"before_date_created,omitempty"`
+		SinceDateCreated       string    `url:"since_date_created,omitempty"`
+		BeforeCampaignLastSent string    `url:"before_campaign_last_sent,omitempty"`
+		SinceCampaignLastSent  string    `url:"since_campaign_last_sent,omitempty"`
+		Email                  string    `url:"email,omitempty"`
+		SortField              SortField `url:"sort_field,omitempty"`
+		SortDir                SortDir   `url:"sort_dir,omitempty"`
+		HasEcommerceStore      *bool     `url:"has_ecommerce_store,omitempty"`
+		IncludeTotalContacts   bool      `url:"include_total_contacts,omitempty"`
 	}{
 		Fields:                 strings.Join(gp.Fields, ","),
 		ExcludeFields:          strings.Join(gp.ExcludeFields, ","),
@@ -219,7 +249,11 @@ func (gp *GetParams) EncodeQueryString(v interface{}) (string, error) {
 		SinceDateCreated:       sinceDateCreated,
 		BeforeCampaignLastSent: beforeCampaignLastSent,
 		SinceCampaignLastSent:  sinceCampaignLastSent,
-		Email: gp.Email,
+		Email:                  gp.Email,
+		SortField:              gp.SortField,
+		SortDir:                gp.SortDir,
+		HasEcommerceStore:      gp.HasEcommerceStore,
+		IncludeTotalContacts:   gp.IncludeTotalContacts,
 	})
 }
 
@@ -245,15 +279,20 @@ func (gmp *GetListParams) EncodeQueryString(v interface{}) (string, error) {
 
 // UpdateParams defines the available parameters that can be used when
 // updating a list via the Update function.
+//
+// Fields that hold pointers are only sent when they are non-nil, which
+// allows them to be explicitly set to their zero value. Use the
+// mailchimp.Bool and mailchimp.String helpers to set them, e.g. to
+// turn off the archive bar or clear a notification address.
 type UpdateParams struct {
 	Name                string            `json:"name,omitempty"`
 	Contact             *Contact          `json:"contact,omitempty"`
 	PermissionReminder  string            `json:"permission_reminder,omitempty"`
-	UseArchiveBar       bool              `json:"use_archive_bar,omitempty"`
+	UseArchiveBar       *bool             `json:"use_archive_bar,omitempty"`
 	CampaignDefaults    *CampaignDefaults `json:"campaign_defaults,omitempty"`
-	NotifyOnSubscribe   string            `json:"notify_on_subscribe,omitempty"`
-	NotifyOnUnsubscribe string            `json:"notify_on_unsubscribe,omitempty"`
-	EmailTypeOption     bool              `json:"email_type_option,omitempty"`
+	NotifyOnSubscribe   *string           `json:"notify_on_subscribe,omitempty"`
+	NotifyOnUnsubscribe *string           `json:"notify_on_unsubscribe,omitempty"`
+	EmailTypeOption     *bool             `json:"email_type_option,omitempty"`
 	Visibility          Visibility        `json:"visibility,omitempty"`
 }
 
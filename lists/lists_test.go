@@ -34,6 +34,111 @@ func TestListUnmarshal(t *testing.T) {
 	}
 }
 
+func TestUpdateParamsMarshal(t *testing.T) {
+	params := &UpdateParams{
+		UseArchiveBar:     mailchimp.Bool(false),
+		NotifyOnSubscribe: mailchimp.String(""),
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := `{"use_archive_bar":false,"notify_on_subscribe":""}`
+	if string(data) != expected {
+		t.Errorf("Expected params to marshal to %s, got %s", expected, string(data))
+	}
+}
+
+func TestGetParamsEncode(t *testing.T) {
+	params := &GetParams{
+		SortField:            SortFieldDateCreated,
+		SortDir:              SortDirDesc,
+		HasEcommerceStore:    mailchimp.Bool(false),
+		IncludeTotalContacts: true,
+	}
+
+	q, err := params.EncodeQueryString(params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := "has_ecommerce_store=false&include_total_contacts=true&sort_dir=DESC&sort_field=date_created"
+	if q != expected {
+		t.Errorf("Expected query string to equal %s, got %s", expected, q)
+	}
+}
+
+func TestGrowthHistoryUnmarshal(t *testing.T) {
+	data := []byte(`{
+		"list_id": "abc123",
+		"month": "2020-01",
+		"existing": 10,
+		"subscribed": 3,
+		"transactional": 1
+	}`)
+
+	gh := &GrowthHistory{}
+	if err := json.NewDecoder(bytes.NewBuffer(data)).Decode(gh); err != nil {
+		t.Error(err)
+	}
+
+	if gh.Month.Year() != 2020 || gh.Month.Month() != time.January {
+		t.Errorf("Expected gh.Month to equal January 2020, got %s", gh.Month)
+	}
+	if gh.Existing != 10 || gh.Subscribed != 3 || gh.Transactional != 1 {
+		t.Errorf("Expected counts to be decoded, got %+v", gh)
+	}
+}
+
+func TestActivityUnmarshal(t *testing.T) {
+	data := []byte(`{
+		"activity": [
+			{
+				"day": "2020-01-02",
+				"emails_sent": 100,
+				"unique_opens": 40
+			}
+		],
+		"total_items": 1
+	}`)
+
+	activity := &ListActivity{}
+	if err := json.NewDecoder(bytes.NewBuffer(data)).Decode(activity); err != nil {
+		t.Error(err)
+	}
+
+	day := activity.Activity[0].Day
+	if day.Year() != 2020 || day.Month() != time.January || day.Day() != 2 {
+		t.Errorf("Expected day to equal 2020-01-02, got %s", day)
+	}
+	if activity.Activity[0].EmailsSent != 100 {
+		t.Errorf("Expected EmailsSent to equal 100, got %d", activity.Activity[0].EmailsSent)
+	}
+}
+
+func TestAbuseReportUnmarshal(t *testing.T) {
+	data := []byte(`{
+		"id": 1,
+		"campaign_id": "abc123",
+		"email_address": "user@example.com",
+		"date": "2020-01-02T23:59:59+00:00"
+	}`)
+
+	report := &AbuseReport{}
+	if err := json.NewDecoder(bytes.NewBuffer(data)).Decode(report); err != nil {
+		t.Error(err)
+	}
+
+	if report.Date.String() != timeString {
+		t.Errorf("Expected report.Date.String() to equal %s, got %s", timeString, report.Date.String())
+	}
+	if report.CampaignID != "abc123" {
+		t.Errorf("Expected report.CampaignID to equal \"abc123\", got %s", report.CampaignID)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	list, err := createList()
 	if err != nil {
@@ -187,6 +292,49 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestCustomizeSignupForm(t *testing.T) {
+	list, err := createList()
+	if err != nil {
+		t.Error(err)
+	}
+
+	params := &CustomizeSignupFormParams{
+		Header: &SignupFormHeader{
+			Text: "mailchimp-go Test Form",
+		},
+		Styles: []SignupFormStyle{
+			{
+				Selector: SignupFormSelectorPageBackground,
+				Options: []SignupFormStyleOption{
+					{Property: "background-color", Value: "#ffffff"},
+				},
+			},
+		},
+	}
+
+	form, err := CustomizeSignupForm(list.ID, params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if form.Header.Text != "mailchimp-go Test Form" {
+		t.Errorf("Expected form.Header.Text to equal \"mailchimp-go Test Form\", got %s", form.Header.Text)
+	}
+
+	forms, err := GetSignupForms(list.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if forms.TotalItems != 1 {
+		t.Errorf("Expected forms.TotalItems to equal 1, got %d", forms.TotalItems)
+	}
+
+	if err = Delete(list.ID); err != nil {
+		t.Error(err)
+	}
+}
+
 func createList() (*List, error) {
 	params := &NewParams{
 		Name: "mailchimp-go Test List",
@@ -0,0 +1,224 @@
+package members
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+	"github.com/beeker1121/mailchimp-go/query"
+)
+
+// ActivityType defines the type of an activity performed by or on a
+// member.
+type ActivityType string
+
+// The activity type definitions.
+const (
+	ActivityTypeOpen   ActivityType = "open"
+	ActivityTypeClick  ActivityType = "click"
+	ActivityTypeBounce ActivityType = "bounce"
+	ActivityTypeUnsub  ActivityType = "unsub"
+	ActivityTypeSent   ActivityType = "sent"
+	ActivityTypeNote   ActivityType = "note"
+	ActivityTypeEvent  ActivityType = "event"
+	ActivityTypeSignup ActivityType = "signup"
+)
+
+// Activity defines a single activity of a member, such as an email
+// open or a link click.
+type Activity struct {
+	Action         ActivityType `json:"action"`
+	Timestamp      time.Time    `json:"timestamp"`
+	URL            string       `json:"url,omitempty"`
+	Type           string       `json:"type,omitempty"`
+	CampaignID     string       `json:"campaign_id,omitempty"`
+	Title          string       `json:"title,omitempty"`
+	ParentCampaign string       `json:"parent_campaign,omitempty"`
+}
+
+// UnmarshalJSON handles custom JSON unmarshalling for the Activity
+// object.
+// Credit to http://choly.ca/post/go-json-marshalling/
+func (a *Activity) UnmarshalJSON(data []byte) error {
+	var err error
+	type alias Activity
+
+	aux := &struct {
+		*alias
+		Timestamp string `json:"timestamp"`
+	}{
+		alias: (*alias)(a),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	if aux.Timestamp != "" {
+		if a.Timestamp, err = time.Parse(time.RFC3339, aux.Timestamp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MemberActivity defines the recent activity of a member.
+type MemberActivity struct {
+	Activity   []Activity `json:"activity,omitempty"`
+	EmailID    string     `json:"email_id"`
+	ListID     string     `json:"list_id"`
+	TotalItems int        `json:"total_items"`
+}
+
+// FeedActivity defines a single entry within the activity feed of a
+// member.
+type FeedActivity struct {
+	ActivityType          ActivityType           `json:"activity_type"`
+	CreatedAtTimestamp    time.Time              `json:"created_at_timestamp"`
+	CampaignID            string                 `json:"campaign_id,omitempty"`
+	CampaignTitle         string                 `json:"campaign_title,omitempty"`
+	LinkClicked           string                 `json:"link_clicked,omitempty"`
+	BounceType            string                 `json:"bounce_type,omitempty"`
+	BounceHasOpenActivity bool                   `json:"bounce_has_open_activity,omitempty"`
+	UnsubscribeReason     string                 `json:"unsubscribe_reason,omitempty"`
+	NoteID                int                    `json:"note_id,omitempty"`
+	NoteText              string                 `json:"note_text,omitempty"`
+	EventName             string                 `json:"event_name,omitempty"`
+	EventProperties       map[string]interface{} `json:"event_properties,omitempty"`
+}
+
+// UnmarshalJSON handles custom JSON unmarshalling for the FeedActivity
+// object.
+// Credit to http://choly.ca/post/go-json-marshalling/
+func (fa *FeedActivity) UnmarshalJSON(data []byte) error {
+	var err error
+	type alias FeedActivity
+
+	aux := &struct {
+		*alias
+		CreatedAtTimestamp string `json:"created_at_timestamp"`
+	}{
+		alias: (*alias)(fa),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	if aux.CreatedAtTimestamp != "" {
+		if fa.CreatedAtTimestamp, err = time.Parse(time.RFC3339, aux.CreatedAtTimestamp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ActivityFeed defines the activity feed of a member.
+type ActivityFeed struct {
+	Activity   []FeedActivity `json:"activity,omitempty"`
+	EmailID    string         `json:"email_id"`
+	ListID     string         `json:"list_id"`
+	TotalItems int            `json:"total_items"`
+}
+
+// GetActivityParams defines the available parameters that can be used
+// when getting the recent activity of a member via the GetActivity
+// function.
+type GetActivityParams struct {
+	Fields        []string       `url:"fields,omitempty"`
+	ExcludeFields []string       `url:"exclude_fields,omitempty"`
+	Action        []ActivityType `url:"action,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetActivityParams object.
+func (gap *GetActivityParams) EncodeQueryString(v interface{}) (string, error) {
+	return query.Encode(struct {
+		Fields        string `url:"fields,omitempty"`
+		ExcludeFields string `url:"exclude_fields,omitempty"`
+		Action        string `url:"action,omitempty"`
+	}{
+		Fields:        strings.Join(gap.Fields, ","),
+		ExcludeFields: strings.Join(gap.ExcludeFields, ","),
+		Action:        joinActivityTypes(gap.Action),
+	})
+}
+
+// GetActivityFeedParams defines the available parameters that can be
+// used when getting the activity feed of a member via the
+// GetActivityFeed function.
+type GetActivityFeedParams struct {
+	Fields          []string       `url:"fields,omitempty"`
+	ExcludeFields   []string       `url:"exclude_fields,omitempty"`
+	Count           int            `url:"count,omitempty"`
+	Offset          int            `url:"offset,omitempty"`
+	ActivityFilters []ActivityType `url:"activity_filters,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetActivityFeedParams object.
+func (gafp *GetActivityFeedParams) EncodeQueryString(v interface{}) (string, error) {
+	return query.Encode(struct {
+		Fields          string `url:"fields,omitempty"`
+		ExcludeFields   string `url:"exclude_fields,omitempty"`
+		Count           int    `url:"count,omitempty"`
+		Offset          int    `url:"offset,omitempty"`
+		ActivityFilters string `url:"activity_filters,omitempty"`
+	}{
+		Fields:          strings.Join(gafp.Fields, ","),
+		ExcludeFields:   strings.Join(gafp.ExcludeFields, ","),
+		Count:           gafp.Count,
+		Offset:          gafp.Offset,
+		ActivityFilters: joinActivityTypes(gafp.ActivityFilters),
+	})
+}
+
+// joinActivityTypes joins the given activity types into a comma
+// separated string.
+func joinActivityTypes(types []ActivityType) string {
+	s := make([]string, len(types))
+	for i, t := range types {
+		s[i] = string(t)
+	}
+	return strings.Join(s, ",")
+}
+
+// GetActivity retrieves the last 50 events of a member's activity.
+func GetActivity(listID, hash string, params *GetActivityParams) (*MemberActivity, error) {
+	res := &MemberActivity{}
+	path := fmt.Sprintf("lists/%s/members/%s/activity", listID, hash)
+
+	if params == nil {
+		if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", path, params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetActivityFeed retrieves the activity feed of a member.
+func GetActivityFeed(listID, hash string, params *GetActivityFeedParams) (*ActivityFeed, error) {
+	res := &ActivityFeed{}
+	path := fmt.Sprintf("lists/%s/members/%s/activity-feed", listID, hash)
+
+	if params == nil {
+		if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", path, params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
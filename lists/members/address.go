@@ -0,0 +1,210 @@
+package members
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Reason defines why an email address failed validation.
+type Reason string
+
+// The validation reason definitions.
+const (
+	ReasonEmpty      Reason = "empty"
+	ReasonSyntax     Reason = "syntax"
+	ReasonLocalPart  Reason = "local part"
+	ReasonDomain     Reason = "domain"
+	ReasonDisposable Reason = "disposable"
+	ReasonRole       Reason = "role"
+)
+
+// ValidationError is returned when an email address fails validation.
+type ValidationError struct {
+	EmailAddress string
+	Reason       Reason
+}
+
+// Error satisfies the error interface method.
+func (ve *ValidationError) Error() string {
+	return fmt.Sprintf("members: Invalid email address %q: %s", ve.EmailAddress, ve.Reason)
+}
+
+// Blocklist defines a list of blocked email addresses.
+type Blocklist interface {
+	// Blocked reports whether the address made of the given local
+	// part and domain is blocked, and why. Both are lowercase.
+	Blocked(local, domain string) (Reason, bool)
+}
+
+// DomainBlocklist is a Blocklist of domains, such as disposable email
+// providers. Domains must be lowercase.
+type DomainBlocklist map[string]bool
+
+// Blocked satisfies the Blocklist interface method.
+func (db DomainBlocklist) Blocked(local, domain string) (Reason, bool) {
+	return ReasonDisposable, db[domain]
+}
+
+// RoleBlocklist is a Blocklist of role local parts, such as "admin"
+// or "info", which usually do not belong to a single person.
+type RoleBlocklist map[string]bool
+
+// Blocked satisfies the Blocklist interface method.
+func (rb RoleBlocklist) Blocked(local, domain string) (Reason, bool) {
+	return ReasonRole, rb[local]
+}
+
+// DefaultRoleBlocklist is a RoleBlocklist of common role addresses.
+var DefaultRoleBlocklist = RoleBlocklist{
+	"abuse":         true,
+	"admin":         true,
+	"administrator": true,
+	"billing":       true,
+	"compliance":    true,
+	"contact":       true,
+	"help":          true,
+	"hostmaster":    true,
+	"info":          true,
+	"marketing":     true,
+	"noc":           true,
+	"no-reply":      true,
+	"noreply":       true,
+	"office":        true,
+	"postmaster":    true,
+	"root":          true,
+	"sales":         true,
+	"security":      true,
+	"support":       true,
+	"webmaster":     true,
+}
+
+// Validator validates and normalizes email addresses.
+type Validator struct {
+	Blocklists []Blocklist
+}
+
+// Normalize validates an email address and returns its normalized
+// form. Surrounding whitespace is trimmed and the address is
+// lowercased. Internationalized domain names are kept as is, and are
+// not converted to their ASCII form. A *ValidationError is returned for
+// invalid addresses.
+func (v *Validator) Normalize(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return "", &ValidationError{EmailAddress: email, Reason: ReasonEmpty}
+	}
+
+	at := strings.LastIndex(email, "@")
+	if at == -1 {
+		return "", &ValidationError{EmailAddress: email, Reason: ReasonSyntax}
+	}
+	local, domain := email[:at], email[at+1:]
+
+	if !validLocalPart(local) {
+		return "", &ValidationError{EmailAddress: email, Reason: ReasonLocalPart}
+	}
+
+	if !validDomain(domain) {
+		return "", &ValidationError{EmailAddress: email, Reason: ReasonDomain}
+	}
+
+	for _, bl := range v.Blocklists {
+		if reason, blocked := bl.Blocked(local, domain); blocked {
+			return "", &ValidationError{EmailAddress: email, Reason: reason}
+		}
+	}
+
+	return local + "@" + domain, nil
+}
+
+// Hash validates an email address and returns the subscriber hash of
+// its normalized form.
+func (v *Validator) Hash(email string) (string, error) {
+	email, err := v.Normalize(email)
+	if err != nil {
+		return "", err
+	}
+
+	return Hash(email), nil
+}
+
+// validator is the Validator used by the New and Update functions.
+var validator *Validator
+var validatorMu sync.RWMutex
+
+// SetValidator sets the Validator used to validate and normalize the
+// email address of members passed to the New and Update functions,
+// before any request is made. Passing nil disables validation, which
+// is the default.
+func SetValidator(v *Validator) {
+	validatorMu.Lock()
+	defer validatorMu.Unlock()
+	validator = v
+}
+
+// normalizeEmail normalizes an email address with the Validator set
+// via SetValidator, if any.
+func normalizeEmail(email string) (string, error) {
+	validatorMu.RLock()
+	v := validator
+	validatorMu.RUnlock()
+
+	if v == nil {
+		return email, nil
+	}
+	return v.Normalize(email)
+}
+
+// validLocalPart reports whether the local part of an address is
+// valid. Only unquoted ASCII local parts are accepted.
+func validLocalPart(local string) bool {
+	if local == "" || len(local) > 64 {
+		return false
+	}
+	if local[0] == '.' || local[len(local)-1] == '.' || strings.Contains(local, "..") {
+		return false
+	}
+
+	for i := 0; i < len(local); i++ {
+		c := local[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case strings.IndexByte("!#$%&'*+-/=?^_`{|}~.", c) != -1:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// validDomain reports whether a domain is valid. The domain must have
+// at least two labels and a non-numeric top level domain. Labels may
+// hold non-ASCII characters, and their length is counted in bytes.
+func validDomain(domain string) bool {
+	if len(domain) > 253 {
+		return false
+	}
+
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+
+	for _, label := range labels {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c >= utf8.RuneSelf) {
+				return false
+			}
+		}
+	}
+
+	tld := labels[len(labels)-1]
+	return strings.Trim(tld, "0123456789") != ""
+}
@@ -0,0 +1,83 @@
+package members
+
+import (
+	"fmt"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+)
+
+// MaxBatchSize is the maximum number of members the API accepts in a
+// single batch subscribe request.
+const MaxBatchSize = 500
+
+// BatchSubscribeOptions defines the available options that can be used
+// when batch subscribing members via the BatchSubscribe function.
+type BatchSubscribeOptions struct {
+	UpdateExisting bool `json:"update_existing"`
+}
+
+// BatchError defines an error for a single member of a batch subscribe
+// request.
+type BatchError struct {
+	EmailAddress string `json:"email_address"`
+	Error        string `json:"error"`
+	ErrorCode    string `json:"error_code,omitempty"`
+}
+
+// BatchReport defines the combined result of a batch subscribe.
+type BatchReport struct {
+	NewMembers     []Member     `json:"new_members,omitempty"`
+	UpdatedMembers []Member     `json:"updated_members,omitempty"`
+	Errors         []BatchError `json:"errors,omitempty"`
+	TotalCreated   int          `json:"total_created"`
+	TotalUpdated   int          `json:"total_updated"`
+	ErrorCount     int          `json:"error_count"`
+}
+
+// batchSubscribeParams defines the body of a batch subscribe request.
+type batchSubscribeParams struct {
+	Members        []*NewParams `json:"members"`
+	UpdateExisting bool         `json:"update_existing"`
+}
+
+// BatchSubscribe subscribes, unsubscribes or updates members of a list
+// in batches, depending on the status set for each member.
+//
+// The given members are split into requests of at most MaxBatchSize
+// members, and the results of every request are combined into a single
+// report. If a request fails, the report of the requests completed so
+// far is returned along with the error.
+func BatchSubscribe(listID string, params []*NewParams, opts *BatchSubscribeOptions) (*BatchReport, error) {
+	report := &BatchReport{}
+	path := fmt.Sprintf("lists/%s", listID)
+
+	if opts == nil {
+		opts = &BatchSubscribeOptions{}
+	}
+
+	for start := 0; start < len(params); start += MaxBatchSize {
+		end := start + MaxBatchSize
+		if end > len(params) {
+			end = len(params)
+		}
+
+		body := &batchSubscribeParams{
+			Members:        params[start:end],
+			UpdateExisting: opts.UpdateExisting,
+		}
+
+		res := &BatchReport{}
+		if err := mailchimp.Call("POST", path, nil, body, res); err != nil {
+			return report, err
+		}
+
+		report.NewMembers = append(report.NewMembers, res.NewMembers...)
+		report.UpdatedMembers = append(report.UpdatedMembers, res.UpdatedMembers...)
+		report.Errors = append(report.Errors, res.Errors...)
+		report.TotalCreated += res.TotalCreated
+		report.TotalUpdated += res.TotalUpdated
+		report.ErrorCount += res.ErrorCount
+	}
+
+	return report, nil
+}
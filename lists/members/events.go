@@ -0,0 +1,133 @@
+package members
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+	"github.com/beeker1121/mailchimp-go/query"
+)
+
+// Event defines a custom event of a member.
+type Event struct {
+	Name       string            `json:"name"`
+	Properties map[string]string `json:"properties,omitempty"`
+	OccurredAt time.Time         `json:"occurred_at,omitempty"`
+}
+
+// UnmarshalJSON handles custom JSON unmarshalling for the Event object.
+// Credit to http://choly.ca/post/go-json-marshalling/
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var err error
+	type alias Event
+
+	aux := &struct {
+		*alias
+		OccurredAt string `json:"occurred_at,omitempty"`
+	}{
+		alias: (*alias)(e),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	if aux.OccurredAt != "" {
+		if e.OccurredAt, err = time.Parse(time.RFC3339, aux.OccurredAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MemberEvents defines a list of events of a member.
+type MemberEvents struct {
+	Events     []Event `json:"events,omitempty"`
+	TotalItems int     `json:"total_items"`
+}
+
+// NewEventParams defines the available parameters that can be used
+// when adding a new event to a member via the NewEvent function.
+type NewEventParams struct {
+	Name       string            `json:"name"`
+	Properties map[string]string `json:"properties,omitempty"`
+	IsSyncing  bool              `json:"is_syncing,omitempty"`
+	OccurredAt time.Time         `json:"occurred_at,omitempty"`
+}
+
+// MarshalJSON handles custom JSON marshalling for the NewEventParams
+// object.
+// Credit to http://choly.ca/post/go-json-marshalling/
+func (nep *NewEventParams) MarshalJSON() ([]byte, error) {
+	var occurredAt string
+
+	if !nep.OccurredAt.IsZero() {
+		occurredAt = nep.OccurredAt.Format(time.RFC3339)
+	}
+
+	type alias NewEventParams
+	return json.Marshal(&struct {
+		*alias
+		OccurredAt string `json:"occurred_at,omitempty"`
+	}{
+		alias:      (*alias)(nep),
+		OccurredAt: occurredAt,
+	})
+}
+
+// GetEventsParams defines the available parameters that can be used
+// when getting the events of a member via the GetEvents function.
+type GetEventsParams struct {
+	Fields        []string `url:"fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+	Count         int      `url:"count,omitempty"`
+	Offset        int      `url:"offset,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetEventsParams object.
+func (gep *GetEventsParams) EncodeQueryString(v interface{}) (string, error) {
+	return query.Encode(struct {
+		Fields        string `url:"fields,omitempty"`
+		ExcludeFields string `url:"exclude_fields,omitempty"`
+		Count         int    `url:"count,omitempty"`
+		Offset        int    `url:"offset,omitempty"`
+	}{
+		Fields:        strings.Join(gep.Fields, ","),
+		ExcludeFields: strings.Join(gep.ExcludeFields, ","),
+		Count:         gep.Count,
+		Offset:        gep.Offset,
+	})
+}
+
+// NewEvent adds a new event to a list member.
+func NewEvent(listID, hash string, params *NewEventParams) error {
+	path := fmt.Sprintf("lists/%s/members/%s/events", listID, hash)
+
+	if params == nil {
+		return mailchimp.Call("POST", path, nil, nil, nil)
+	}
+
+	return mailchimp.Call("POST", path, nil, params, nil)
+}
+
+// GetEvents retrieves the events of a list member.
+func GetEvents(listID, hash string, params *GetEventsParams) (*MemberEvents, error) {
+	res := &MemberEvents{}
+	path := fmt.Sprintf("lists/%s/members/%s/events", listID, hash)
+
+	if params == nil {
+		if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", path, params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,7 @@
+// Package exporter implements exporting list members to CSV and JSON
+// Lines.
+//
+// Members are retrieved page by page and written out as they arrive,
+// so lists of any size can be exported without holding every member in
+// memory.
+package exporter
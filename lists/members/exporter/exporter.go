@@ -0,0 +1,456 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/beeker1121/mailchimp-go/lists"
+	"github.com/beeker1121/mailchimp-go/lists/members"
+)
+
+// Format defines the output format of an export.
+type Format int
+
+// The format definitions.
+const (
+	FormatCSV Format = iota
+	FormatJSONLines
+)
+
+// Options defines the available options that can be used when
+// exporting members via the Export function.
+type Options struct {
+	Format Format
+
+	// Fields and ExcludeFields select the member fields to export,
+	// using the field names of the API member object, such as
+	// "email_address" or "merge_fields", or nested field names such
+	// as "merge_fields.FNAME". They are passed on to the API to keep
+	// responses small, and select the CSV columns or JSON keys that
+	// are written.
+	Fields        []string
+	ExcludeFields []string
+
+	// Status only exports members with the given status.
+	Status members.Status
+
+	// PageSize is the number of members retrieved per request. It
+	// defaults to and is capped at members.MaxCount.
+	PageSize int
+}
+
+// baseColumns defines the CSV columns of the top level member fields.
+var baseColumns = []string{
+	"id",
+	"email_address",
+	"unique_email_id",
+	"email_type",
+	"status",
+	"ip_signup",
+	"timestamp_signup",
+	"ip_opt",
+	"timestamp_opt",
+	"member_rating",
+	"last_changed",
+	"language",
+	"vip",
+	"email_client",
+	"list_id",
+}
+
+// locationColumns defines the CSV columns of the member location.
+var locationColumns = []string{
+	"location.latitude",
+	"location.longitude",
+	"location.gmtoff",
+	"location.dstoff",
+	"location.country_code",
+	"location.timezone",
+}
+
+// statsColumns defines the CSV columns of the member stats.
+var statsColumns = []string{
+	"stats.avg_open_rate",
+	"stats.avg_click_rate",
+}
+
+// Export writes every member of a list to w in the format set in the
+// options, and returns the number of members written.
+func Export(listID string, w io.Writer, opts *Options) (int, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	params := &members.GetParams{
+		Fields:        prefixFields(opts.Fields),
+		ExcludeFields: prefixFields(opts.ExcludeFields),
+		Count:         opts.PageSize,
+		Status:        opts.Status,
+	}
+
+	var n int
+	var write func(*members.Member) error
+	var flush func() error
+
+	switch opts.Format {
+	case FormatJSONLines:
+		jw := &jsonWriter{enc: json.NewEncoder(w), opts: opts}
+		write = jw.write
+		flush = func() error { return nil }
+	case FormatCSV:
+		mergeFields, err := lists.GetMergeFields(listID, &lists.GetMergeFieldsParams{
+			Fields: []string{"merge_fields.tag"},
+			Count:  lists.MaxMergeFieldCount,
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		cw := &csvWriter{w: csv.NewWriter(w), opts: opts}
+		for _, mf := range mergeFields.MergeFields {
+			cw.mergeFields = append(cw.mergeFields, mf.Tag)
+		}
+		write = cw.write
+		flush = cw.flush
+	default:
+		return 0, fmt.Errorf("exporter: Unknown format %d", opts.Format)
+	}
+
+	err := members.Each(listID, params, func(m *members.Member) error {
+		if err := write(m); err != nil {
+			return err
+		}
+		n++
+		return nil
+	})
+	if err != nil {
+		return n, err
+	}
+
+	return n, flush()
+}
+
+// prefixFields prefixes the given member field names so they can be
+// used as fields of the members.Get function.
+func prefixFields(fields []string) []string {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	res := make([]string, len(fields))
+	for i, f := range fields {
+		res[i] = "members." + f
+	}
+	return res
+}
+
+// csvWriter writes members as flattened CSV records.
+type csvWriter struct {
+	w    *csv.Writer
+	opts *Options
+
+	// mergeFields are the merge field tags of the list.
+	mergeFields []string
+	columns     []string
+}
+
+// write writes a single member, writing the header first if needed.
+func (cw *csvWriter) write(m *members.Member) error {
+	if cw.columns == nil {
+		if err := cw.writeHeader(m.Interests); err != nil {
+			return err
+		}
+	}
+
+	record := make([]string, len(cw.columns))
+	for i, c := range cw.columns {
+		record[i] = value(m, c)
+	}
+	return cw.w.Write(record)
+}
+
+// flush writes the header if no member was written, and flushes the
+// CSV writer.
+func (cw *csvWriter) flush() error {
+	if cw.columns == nil {
+		if err := cw.writeHeader(nil); err != nil {
+			return err
+		}
+	}
+
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// writeHeader writes the CSV header.
+func (cw *csvWriter) writeHeader(interests map[string]bool) error {
+	cw.columns = cw.header(interests)
+	return cw.w.Write(cw.columns)
+}
+
+// header returns the CSV columns selected by the field options.
+//
+// Merge field columns are taken from the merge fields of the list. The
+// interests of a list are not known in advance, so interest columns
+// are taken from the given interests of the first member written, the
+// API returning every interest of the list for each member, along with
+// the interests named in the field options.
+func (cw *csvWriter) header(memberInterests map[string]bool) []string {
+	var mergeFields []string
+	for _, tag := range cw.mergeFields {
+		mergeFields = append(mergeFields, "merge_fields."+tag)
+	}
+	sort.Strings(mergeFields)
+
+	ids := make(map[string]bool)
+	for id := range memberInterests {
+		ids[id] = true
+	}
+	for _, f := range cw.opts.Fields {
+		if strings.HasPrefix(f, "interests.") {
+			ids[strings.TrimPrefix(f, "interests.")] = true
+		}
+	}
+
+	var interests []string
+	for id := range ids {
+		interests = append(interests, "interests."+id)
+	}
+	sort.Strings(interests)
+
+	var columns []string
+	for _, set := range [][]string{baseColumns, mergeFields, interests, locationColumns, statsColumns} {
+		for _, c := range set {
+			if cw.included(c) {
+				columns = append(columns, c)
+			}
+		}
+	}
+	return columns
+}
+
+// included reports whether the given column is selected by the field
+// options. A column is selected by its own name or any of its parents,
+// such as "merge_fields" for "merge_fields.FNAME".
+func (cw *csvWriter) included(column string) bool {
+	if len(cw.opts.Fields) > 0 && !matchField(cw.opts.Fields, column) {
+		return false
+	}
+
+	return !matchField(cw.opts.ExcludeFields, column)
+}
+
+// matchField reports whether the column matches one of the fields.
+func matchField(fields []string, column string) bool {
+	for _, f := range fields {
+		if column == f || strings.HasPrefix(column, f+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonWriter writes members as JSON objects, one per line.
+type jsonWriter struct {
+	enc  *json.Encoder
+	opts *Options
+}
+
+// write writes a single member. Only the fields selected by the field
+// options are written, and timestamps are written the same way as CSV
+// columns, the zero time being an empty string.
+func (jw *jsonWriter) write(m *members.Member) error {
+	obj, err := jsonObject(m)
+	if err != nil {
+		return err
+	}
+
+	return jw.enc.Encode(jw.filter(obj, ""))
+}
+
+// filter returns the keys of obj selected by the field options, where
+// prefix is the path of obj within the member object.
+func (jw *jsonWriter) filter(obj map[string]interface{}, prefix string) map[string]interface{} {
+	res := make(map[string]interface{})
+	for k, v := range obj {
+		path := prefix + k
+		if matchField(jw.opts.ExcludeFields, path) {
+			continue
+		}
+
+		selected := len(jw.opts.Fields) == 0 || matchField(jw.opts.Fields, path)
+		nested := parentField(jw.opts.Fields, path)
+		if !selected && !nested {
+			continue
+		}
+
+		// Filter the keys of objects when some of their fields are
+		// selected or excluded.
+		if child, ok := v.(map[string]interface{}); ok && (nested || parentField(jw.opts.ExcludeFields, path)) {
+			child = jw.filter(child, path+".")
+			if len(child) == 0 && !selected {
+				continue
+			}
+			v = child
+		}
+
+		res[k] = v
+	}
+	return res
+}
+
+// parentField reports whether one of the fields is nested within the
+// given path, such as "merge_fields.FNAME" for "merge_fields".
+func parentField(fields []string, path string) bool {
+	for _, f := range fields {
+		if strings.HasPrefix(f, path+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonObject returns the member as a generic JSON object, with its
+// timestamps formatted via timeValue.
+func jsonObject(m *members.Member) (map[string]interface{}, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&obj); err != nil {
+		return nil, err
+	}
+
+	setTime(obj, "timestamp_signup", m.TimestampSignup)
+	setTime(obj, "timestamp_opt", m.TimestampOpt)
+	setTime(obj, "last_changed", m.LastChanged)
+	if note, ok := obj["last_note"].(map[string]interface{}); ok && m.LastNote != nil {
+		setTime(note, "created_at", m.LastNote.CreatedAt)
+		setTime(note, "updated_at", m.LastNote.UpdatedAt)
+	}
+
+	return obj, nil
+}
+
+// setTime sets the key of obj to the value of the given time, if the
+// key is present.
+func setTime(obj map[string]interface{}, key string, t time.Time) {
+	if _, ok := obj[key]; ok {
+		obj[key] = timeValue(t)
+	}
+}
+
+// value returns the string value of a column for the given member.
+func value(m *members.Member, column string) string {
+	switch {
+	case strings.HasPrefix(column, "merge_fields."):
+		return mergeFieldValue(m.MergeFields[strings.TrimPrefix(column, "merge_fields.")])
+	case strings.HasPrefix(column, "interests."):
+		return strconv.FormatBool(m.Interests[strings.TrimPrefix(column, "interests.")])
+	case strings.HasPrefix(column, "location."):
+		if m.Location == nil {
+			return ""
+		}
+		return locationValue(m.Location, column)
+	case strings.HasPrefix(column, "stats."):
+		if m.Stats == nil {
+			return ""
+		}
+		if column == "stats.avg_open_rate" {
+			return strconv.FormatFloat(float64(m.Stats.AvgOpenRate), 'f', -1, 32)
+		}
+		return strconv.FormatFloat(float64(m.Stats.AvgClickRate), 'f', -1, 32)
+	}
+
+	switch column {
+	case "id":
+		return m.ID
+	case "email_address":
+		return m.EmailAddress
+	case "unique_email_id":
+		return m.UniqueEmailID
+	case "email_type":
+		return string(m.EmailType)
+	case "status":
+		return string(m.Status)
+	case "ip_signup":
+		return m.IPSignup
+	case "timestamp_signup":
+		return timeValue(m.TimestampSignup)
+	case "ip_opt":
+		return m.IPOpt
+	case "timestamp_opt":
+		return timeValue(m.TimestampOpt)
+	case "member_rating":
+		return strconv.Itoa(int(m.MemberRating))
+	case "last_changed":
+		return timeValue(m.LastChanged)
+	case "language":
+		return m.Language
+	case "vip":
+		return strconv.FormatBool(m.VIP)
+	case "email_client":
+		return m.EmailClient
+	case "list_id":
+		return m.ListID
+	}
+
+	return ""
+}
+
+// locationValue returns the string value of a location column.
+func locationValue(l *members.Location, column string) string {
+	switch column {
+	case "location.latitude":
+		return strconv.FormatFloat(l.Latitude, 'f', -1, 64)
+	case "location.longitude":
+		return strconv.FormatFloat(l.Longitude, 'f', -1, 64)
+	case "location.gmtoff":
+		return strconv.Itoa(l.GMTOff)
+	case "location.dstoff":
+		return strconv.Itoa(l.DSTOff)
+	case "location.country_code":
+		return l.CountryCode
+	case "location.timezone":
+		return l.Timezone
+	}
+
+	return ""
+}
+
+// mergeFieldValue returns the string value of a merge field. Values
+// that are not strings, such as addresses, are encoded as JSON.
+func mergeFieldValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// timeValue returns the RFC3339 value of a time, or an empty string
+// for the zero time.
+func timeValue(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,159 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/beeker1121/mailchimp-go/lists/members"
+)
+
+func TestCSVWriter(t *testing.T) {
+	timeOpt, err := time.Parse(time.RFC3339, "2020-01-02T23:59:59+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &members.Member{
+		EmailAddress: "user@example.com",
+		Status:       members.StatusSubscribed,
+		TimestampOpt: timeOpt,
+		MergeFields:  map[string]interface{}{"LNAME": "Doe", "FNAME": "John"},
+		Interests:    map[string]bool{"abc123": true},
+		Location:     &members.Location{CountryCode: "US"},
+	}
+
+	buf := new(bytes.Buffer)
+	cw := &csvWriter{
+		w: csv.NewWriter(buf),
+		opts: &Options{
+			Fields: []string{"email_address", "timestamp_opt", "merge_fields", "interests", "location.country_code"},
+		},
+		mergeFields: []string{"LNAME", "FNAME"},
+	}
+
+	if err := cw.write(m); err != nil {
+		t.Fatal(err)
+	}
+	if err := cw.flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "email_address,timestamp_opt,merge_fields.FNAME,merge_fields.LNAME,interests.abc123,location.country_code\n" +
+		"user@example.com,2020-01-02T23:59:59Z,John,Doe,true,US\n"
+	if buf.String() != expected {
+		t.Errorf("Expected CSV output to equal %q, got %q", expected, buf.String())
+	}
+}
+
+func TestCSVWriterExcludeFields(t *testing.T) {
+	m := &members.Member{
+		ID:           "123",
+		EmailAddress: "user@example.com",
+		Stats:        &members.Stats{AvgOpenRate: 0.5},
+	}
+
+	cw := &csvWriter{
+		opts: &Options{
+			ExcludeFields: []string{"merge_fields", "interests", "location", "stats.avg_click_rate"},
+		},
+	}
+
+	columns := cw.header(m.Interests)
+	if columns[len(columns)-1] != "stats.avg_open_rate" {
+		t.Errorf("Expected last column to equal stats.avg_open_rate, got %s", columns[len(columns)-1])
+	}
+	if value(m, "stats.avg_open_rate") != "0.5" {
+		t.Errorf("Expected stats.avg_open_rate to equal 0.5, got %s", value(m, "stats.avg_open_rate"))
+	}
+}
+
+func TestCSVWriterEmpty(t *testing.T) {
+	buf := new(bytes.Buffer)
+	cw := &csvWriter{
+		w: csv.NewWriter(buf),
+		opts: &Options{
+			Fields: []string{"email_address", "merge_fields", "interests.abc123"},
+		},
+		mergeFields: []string{"FNAME"},
+	}
+
+	if err := cw.flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "email_address,merge_fields.FNAME,interests.abc123\n"
+	if buf.String() != expected {
+		t.Errorf("Expected CSV output to equal %q, got %q", expected, buf.String())
+	}
+}
+
+func TestPrefixFields(t *testing.T) {
+	fields := prefixFields([]string{"email_address", "merge_fields.FNAME"})
+	if fields[0] != "members.email_address" || fields[1] != "members.merge_fields.FNAME" {
+		t.Errorf("Expected fields to be prefixed with members., got %v", fields)
+	}
+	if prefixFields(nil) != nil {
+		t.Error("Expected prefixFields(nil) to return nil")
+	}
+}
+
+func TestJSONWriter(t *testing.T) {
+	lastChanged, err := time.Parse(time.RFC3339, "2020-01-02T23:59:59+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &members.Member{
+		EmailAddress: "user@example.com",
+		Status:       members.StatusSubscribed,
+		LastChanged:  lastChanged,
+		MergeFields:  map[string]interface{}{"LNAME": "Doe", "FNAME": "John"},
+		Location:     &members.Location{CountryCode: "US"},
+	}
+
+	buf := new(bytes.Buffer)
+	jw := &jsonWriter{
+		enc: json.NewEncoder(buf),
+		opts: &Options{
+			Fields: []string{"email_address", "timestamp_opt", "last_changed", "merge_fields.FNAME", "location.country_code"},
+		},
+	}
+
+	if err := jw.write(m); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"email_address":"user@example.com","last_changed":"2020-01-02T23:59:59Z","location":{"country_code":"US"},"merge_fields":{"FNAME":"John"},"timestamp_opt":""}` + "\n"
+	if buf.String() != expected {
+		t.Errorf("Expected JSON output to equal %q, got %q", expected, buf.String())
+	}
+}
+
+func TestJSONWriterExcludeFields(t *testing.T) {
+	m := &members.Member{
+		ID:           "123",
+		EmailAddress: "user@example.com",
+		ListID:       "abc123",
+		MergeFields:  map[string]interface{}{"LNAME": "Doe", "FNAME": "John"},
+	}
+
+	buf := new(bytes.Buffer)
+	jw := &jsonWriter{
+		enc: json.NewEncoder(buf),
+		opts: &Options{
+			ExcludeFields: []string{"id", "unique_email_id", "status", "list_id", "merge_fields.LNAME"},
+		},
+	}
+
+	if err := jw.write(m); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"email_address":"user@example.com","last_changed":"","merge_fields":{"FNAME":"John"},"timestamp_opt":"","timestamp_signup":""}` + "\n"
+	if buf.String() != expected {
+		t.Errorf("Expected JSON output to equal %q, got %q", expected, buf.String())
+	}
+}
@@ -0,0 +1,7 @@
+// Package importer implements importing list members from CSV data.
+//
+// Each CSV row is mapped to a member using the column mapping given in
+// the import options, validated against the merge fields of the list,
+// and then uploaded either one member at a time or in batches. The
+// result of every row is returned in a report.
+package importer
@@ -0,0 +1,560 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/beeker1121/mailchimp-go/lists"
+	"github.com/beeker1121/mailchimp-go/lists/members"
+)
+
+var (
+	// ErrNoEmailColumn is returned when the CSV data has no column
+	// holding the email address of the members.
+	ErrNoEmailColumn = errors.New("importer: Email address column not found")
+
+	// ErrInvalidStatus is returned for rows with an unknown status, or
+	// a status that cannot be requested such as cleaned.
+	ErrInvalidStatus = errors.New("importer: Invalid status")
+
+	// ErrFieldCount is returned for rows that do not have the same
+	// number of fields as the header.
+	ErrFieldCount = errors.New("importer: Wrong number of fields")
+
+	// ErrDuplicateEmail is returned for rows with an email address
+	// already used by a previous row.
+	ErrDuplicateEmail = errors.New("importer: Duplicate email address")
+)
+
+// Mode defines how members are uploaded to the list.
+type Mode int
+
+// The mode definitions.
+const (
+	// ModeUpsert adds or updates members one at a time.
+	ModeUpsert Mode = iota
+
+	// ModeBatch adds or updates members using batch requests.
+	ModeBatch
+)
+
+// Action defines the outcome of importing a row.
+type Action string
+
+// The action definitions.
+const (
+	ActionCreated  Action = "created"
+	ActionUpdated  Action = "updated"
+	ActionUpserted Action = "upserted"
+	ActionSkipped  Action = "skipped"
+	ActionFailed   Action = "failed"
+)
+
+// Mapping defines how CSV columns are mapped to member fields. Columns
+// are referenced by their header.
+type Mapping struct {
+	// EmailAddress is the column holding the email address. If empty,
+	// a column named "email", "email address" or "email_address" is
+	// used.
+	EmailAddress string
+
+	// Status is the column holding the member status. If empty, the
+	// status given in the import options is used for every member.
+	Status string
+
+	// MergeFields maps columns to merge field tags. If nil, columns
+	// are matched by name against the merge field tags and names of
+	// the list.
+	MergeFields map[string]string
+
+	// Tags is the column holding the member tags.
+	Tags string
+
+	// TagSeparator separates the tags within the tags column. It
+	// defaults to a comma.
+	TagSeparator string
+
+	// Interests maps columns to interest IDs. Cells are parsed as
+	// booleans and empty cells are ignored.
+	Interests map[string]string
+}
+
+// Options defines the available options that can be used when
+// importing members via the Import function.
+type Options struct {
+	Mapping Mapping
+	Mode    Mode
+
+	// Status is the status used for members without a status column.
+	// It defaults to members.StatusPending.
+	//
+	// With ModeUpsert, it is only applied to new members and existing
+	// members keep their current status. With ModeBatch, the batch
+	// request requires a status, so it is also applied to existing
+	// members when UpdateExisting is set.
+	Status members.Status
+
+	// UpdateExisting updates members already in the list when using
+	// ModeBatch. ModeUpsert always updates existing members.
+	UpdateExisting bool
+
+	// Validator validates and normalizes the email address of each
+	// row, failing the row with a *members.ValidationError for invalid
+	// addresses. It defaults to a members.Validator without blocklists.
+	Validator *members.Validator
+}
+
+// RowResult defines the result of importing a single CSV row.
+type RowResult struct {
+	// Row is the 1-based record number within the CSV data, the
+	// header being row 1.
+	Row          int
+	EmailAddress string
+	Action       Action
+	Err          error
+
+	// TagErr is set when the member was uploaded but its tags could
+	// not be applied. The row keeps its action and still counts as
+	// succeeded.
+	TagErr error
+}
+
+// Report defines the result of an import.
+type Report struct {
+	Rows      []RowResult
+	Succeeded int
+	Failed    int
+
+	// TagsFailed is the number of succeeded rows whose tags could not
+	// be applied.
+	TagsFailed int
+}
+
+// row defines a parsed CSV row.
+type row struct {
+	params *members.NewParams
+	tags   []string
+
+	// hasStatus is set when the status was read from the status
+	// column rather than defaulted.
+	hasStatus bool
+}
+
+// column defines a CSV column mapped to a member field.
+type column struct {
+	index int
+	key   string
+}
+
+// parser maps CSV records to members.
+type parser struct {
+	fieldCount    int
+	email         int
+	status        int
+	tags          int
+	tagSeparator  string
+	mergeFields   []column
+	interests     []column
+	required      map[string]bool
+	defaultStatus members.Status
+	validator     *members.Validator
+}
+
+// newParser creates a new parser for the given CSV header.
+func newParser(header []string, opts *Options, mergeFields []lists.MergeField) (*parser, error) {
+	p := &parser{
+		fieldCount:    len(header),
+		email:         -1,
+		status:        -1,
+		tags:          -1,
+		tagSeparator:  opts.Mapping.TagSeparator,
+		required:      make(map[string]bool),
+		defaultStatus: opts.Status,
+		validator:     opts.Validator,
+	}
+
+	if p.tagSeparator == "" {
+		p.tagSeparator = ","
+	}
+	if p.defaultStatus == "" {
+		p.defaultStatus = members.StatusPending
+	}
+	if p.validator == nil {
+		p.validator = &members.Validator{}
+	}
+
+	index := make(map[string]int)
+	for i, h := range header {
+		index[strings.TrimSpace(h)] = i
+	}
+
+	// Find the email column.
+	if opts.Mapping.EmailAddress != "" {
+		if i, ok := index[opts.Mapping.EmailAddress]; ok {
+			p.email = i
+		}
+	} else {
+		for i := len(header) - 1; i >= 0; i-- {
+			switch strings.ToLower(strings.TrimSpace(header[i])) {
+			case "email", "email address", "email_address":
+				p.email = i
+			}
+		}
+	}
+	if p.email == -1 {
+		return nil, ErrNoEmailColumn
+	}
+
+	// Find the status and tags columns.
+	if opts.Mapping.Status != "" {
+		i, ok := index[opts.Mapping.Status]
+		if !ok {
+			return nil, fmt.Errorf("importer: Status column %s not found", opts.Mapping.Status)
+		}
+		p.status = i
+	}
+	if opts.Mapping.Tags != "" {
+		i, ok := index[opts.Mapping.Tags]
+		if !ok {
+			return nil, fmt.Errorf("importer: Tags column %s not found", opts.Mapping.Tags)
+		}
+		p.tags = i
+	}
+
+	// Map the merge field columns.
+	tags := make(map[string]bool)
+	for _, mf := range mergeFields {
+		tags[mf.Tag] = true
+		if mf.Required {
+			p.required[mf.Tag] = true
+		}
+	}
+
+	if opts.Mapping.MergeFields != nil {
+		for h, tag := range opts.Mapping.MergeFields {
+			i, ok := index[h]
+			if !ok {
+				return nil, fmt.Errorf("importer: Merge field column %s not found", h)
+			}
+			if !tags[tag] {
+				return nil, fmt.Errorf("importer: List has no merge field with tag %s", tag)
+			}
+			p.mergeFields = append(p.mergeFields, column{index: i, key: tag})
+		}
+	} else {
+		for i, h := range header {
+			if i == p.email || i == p.status || i == p.tags {
+				continue
+			}
+			h = strings.TrimSpace(h)
+			for _, mf := range mergeFields {
+				if strings.EqualFold(h, mf.Tag) || strings.EqualFold(h, mf.Name) {
+					p.mergeFields = append(p.mergeFields, column{index: i, key: mf.Tag})
+					break
+				}
+			}
+		}
+	}
+
+	// Map the interest columns.
+	for h, id := range opts.Mapping.Interests {
+		i, ok := index[h]
+		if !ok {
+			return nil, fmt.Errorf("importer: Interest column %s not found", h)
+		}
+		p.interests = append(p.interests, column{index: i, key: id})
+	}
+
+	return p, nil
+}
+
+// parse maps and validates a CSV record.
+func (p *parser) parse(record []string) (*row, error) {
+	if len(record) != p.fieldCount {
+		return nil, ErrFieldCount
+	}
+
+	email, err := p.validator.Normalize(record[p.email])
+	if err != nil {
+		return nil, err
+	}
+
+	status := p.defaultStatus
+	var hasStatus bool
+	if p.status != -1 {
+		if s := strings.ToLower(strings.TrimSpace(record[p.status])); s != "" {
+			status = members.Status(s)
+			hasStatus = true
+		}
+	}
+	if !status.Requestable() {
+		return nil, ErrInvalidStatus
+	}
+
+	r := &row{
+		params: &members.NewParams{
+			EmailAddress: email,
+			Status:       status,
+		},
+		hasStatus: hasStatus,
+	}
+
+	for _, c := range p.mergeFields {
+		v := strings.TrimSpace(record[c.index])
+		if v == "" {
+			continue
+		}
+		if r.params.MergeFields == nil {
+			r.params.MergeFields = make(map[string]interface{})
+		}
+		r.params.MergeFields[c.key] = v
+	}
+	for tag := range p.required {
+		if _, ok := r.params.MergeFields[tag]; !ok {
+			return nil, fmt.Errorf("importer: Missing required merge field %s", tag)
+		}
+	}
+
+	for _, c := range p.interests {
+		v := strings.TrimSpace(record[c.index])
+		if v == "" {
+			continue
+		}
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("importer: Invalid interest value %s", v)
+		}
+		if r.params.Interests == nil {
+			r.params.Interests = make(map[string]bool)
+		}
+		r.params.Interests[c.key] = b
+	}
+
+	if p.tags != -1 {
+		for _, tag := range strings.Split(record[p.tags], p.tagSeparator) {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				r.tags = append(r.tags, tag)
+			}
+		}
+	}
+
+	return r, nil
+}
+
+// parseAll parses every row of the CSV data following the header. It
+// returns the result of every row, the valid rows to upload and the
+// index of the result of each valid row.
+//
+// Rows with an email address already used by a previous row, once
+// normalized, are reported as failed with ErrDuplicateEmail.
+func (p *parser) parseAll(reader *csv.Reader) ([]RowResult, []*row, []int, error) {
+	var results []RowResult
+	var rows []*row
+	var valid []int
+
+	seen := make(map[string]bool)
+	for n := 2; ; n++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		res := RowResult{Row: n}
+		if len(record) > p.email {
+			res.EmailAddress = strings.TrimSpace(record[p.email])
+		}
+
+		row, err := p.parse(record)
+		if err == nil && seen[row.params.EmailAddress] {
+			err = ErrDuplicateEmail
+		}
+
+		if err != nil {
+			res.Action = ActionFailed
+			res.Err = err
+		} else {
+			seen[row.params.EmailAddress] = true
+			rows = append(rows, row)
+			valid = append(valid, len(results))
+		}
+		results = append(results, res)
+	}
+
+	return results, rows, valid, nil
+}
+
+// Import reads members from CSV data and adds or updates them within
+// the given list.
+//
+// The first CSV record must be a header naming the columns. Rows that
+// fail validation, including rows repeating the email address of a
+// previous row, are reported as failed and are not uploaded. An error
+// is only returned if the import could not be performed at all, such as
+// when the CSV data is malformed or the list merge fields could not be
+// retrieved.
+func Import(listID string, r io.Reader, opts *Options) (*Report, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	mergeFields, err := lists.GetMergeFields(listID, &lists.GetMergeFieldsParams{Count: lists.MaxMergeFieldCount})
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := newParser(header, opts, mergeFields.MergeFields)
+	if err != nil {
+		return nil, err
+	}
+
+	results, rows, valid, err := p.parseAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	// Upload the valid rows.
+	if opts.Mode == ModeBatch {
+		uploadBatch(listID, opts, rows, valid, results)
+	} else {
+		uploadUpsert(listID, rows, valid, results)
+	}
+
+	applyTags(listID, rows, valid, results)
+
+	report := &Report{Rows: results}
+	for _, res := range results {
+		if res.Err != nil {
+			report.Failed++
+			continue
+		}
+		report.Succeeded++
+		if res.TagErr != nil {
+			report.TagsFailed++
+		}
+	}
+
+	return report, nil
+}
+
+// applyTags applies the tags of the uploaded rows. Failures are stored
+// in the TagErr field of the row results, leaving their action as is.
+func applyTags(listID string, rows []*row, valid []int, results []RowResult) {
+	for i, row := range rows {
+		res := &results[valid[i]]
+		if res.Err != nil || len(row.tags) == 0 || res.Action == ActionSkipped {
+			continue
+		}
+
+		params := &members.UpdateTagsParams{}
+		for _, tag := range row.tags {
+			params.Tags = append(params.Tags, members.TagUpdate{Name: tag, Status: members.TagStatusActive})
+		}
+		if err := members.UpdateTags(listID, members.Hash(row.params.EmailAddress), params); err != nil {
+			res.TagErr = err
+		}
+	}
+}
+
+// uploadUpsert adds or updates the given rows one at a time.
+func uploadUpsert(listID string, rows []*row, valid []int, results []RowResult) {
+	for i, row := range rows {
+		res := &results[valid[i]]
+		params := upsertParams(row)
+
+		m, err := members.Update(listID, members.Hash(row.params.EmailAddress), params)
+		if err != nil {
+			res.Action = ActionFailed
+			res.Err = err
+			continue
+		}
+		res.Action = ActionUpserted
+
+		// Tags are applied using the address as stored by the API,
+		// which may have been normalized.
+		if m.EmailAddress != "" {
+			row.params.EmailAddress = m.EmailAddress
+		}
+	}
+}
+
+// upsertParams returns the parameters used to add or update the member
+// of a row. The status of existing members is only changed when the
+// row has a status.
+func upsertParams(row *row) *members.UpdateParams {
+	params := &members.UpdateParams{
+		EmailAddress: row.params.EmailAddress,
+		StatusIfNew:  row.params.Status,
+		MergeFields:  row.params.MergeFields,
+		Interests:    row.params.Interests,
+	}
+	if row.hasStatus {
+		params.Status = row.params.Status
+	}
+
+	return params
+}
+
+// uploadBatch adds or updates the given rows using batch requests.
+func uploadBatch(listID string, opts *Options, rows []*row, valid []int, results []RowResult) {
+	params := make([]*members.NewParams, len(rows))
+	byEmail := make(map[string]*RowResult)
+	for i, row := range rows {
+		params[i] = row.params
+		byEmail[strings.ToLower(row.params.EmailAddress)] = &results[valid[i]]
+	}
+
+	report, err := members.BatchSubscribe(listID, params, &members.BatchSubscribeOptions{
+		UpdateExisting: opts.UpdateExisting,
+	})
+	mapBatchReport(report, err, byEmail)
+}
+
+// mapBatchReport sets the result of each uploaded row from the batch
+// report, where byEmail maps lowercased email addresses to the results.
+func mapBatchReport(report *members.BatchReport, err error, byEmail map[string]*RowResult) {
+	for _, m := range report.NewMembers {
+		if res, ok := byEmail[strings.ToLower(m.EmailAddress)]; ok {
+			res.Action = ActionCreated
+		}
+	}
+	for _, m := range report.UpdatedMembers {
+		if res, ok := byEmail[strings.ToLower(m.EmailAddress)]; ok {
+			res.Action = ActionUpdated
+		}
+	}
+	for _, e := range report.Errors {
+		if res, ok := byEmail[strings.ToLower(e.EmailAddress)]; ok {
+			res.Action = ActionFailed
+			res.Err = errors.New(e.Error)
+		}
+	}
+
+	// Rows missing from the report were either part of a failed
+	// request or left untouched by the API.
+	for _, res := range byEmail {
+		if res.Action != "" {
+			continue
+		}
+		if err != nil {
+			res.Action = ActionFailed
+			res.Err = err
+			continue
+		}
+		res.Action = ActionSkipped
+	}
+}
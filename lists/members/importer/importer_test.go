@@ -0,0 +1,276 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/beeker1121/mailchimp-go"
+	"github.com/beeker1121/mailchimp-go/lists"
+	"github.com/beeker1121/mailchimp-go/lists/members"
+)
+
+var mergeFields = []lists.MergeField{
+	{Tag: "FNAME", Name: "First Name"},
+	{Tag: "LNAME", Name: "Last Name"},
+}
+
+func TestParse(t *testing.T) {
+	header := []string{"Email Address", "First Name", "LNAME", "Status", "Tags", "Newsletter"}
+	opts := &Options{
+		Mapping: Mapping{
+			Status:    "Status",
+			Tags:      "Tags",
+			Interests: map[string]string{"Newsletter": "abc123"},
+		},
+	}
+
+	p, err := newParser(header, opts, mergeFields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row, err := p.parse([]string{" User@Example.COM ", "John", "Doe", "Subscribed", "vip, event", "true"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if row.params.EmailAddress != "user@example.com" {
+		t.Errorf("Expected EmailAddress to equal \"user@example.com\", got %s", row.params.EmailAddress)
+	}
+	if row.params.Status != members.StatusSubscribed {
+		t.Errorf("Expected Status to equal %s, got %s", members.StatusSubscribed, row.params.Status)
+	}
+	if row.params.MergeFields["FNAME"] != "John" || row.params.MergeFields["LNAME"] != "Doe" {
+		t.Errorf("Expected merge fields to be mapped, got %v", row.params.MergeFields)
+	}
+	if !row.params.Interests["abc123"] {
+		t.Errorf("Expected interest abc123 to be true, got %v", row.params.Interests)
+	}
+	if len(row.tags) != 2 || row.tags[0] != "vip" || row.tags[1] != "event" {
+		t.Errorf("Expected tags to equal [vip event], got %v", row.tags)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	header := []string{"email", "status", "FNAME"}
+	opts := &Options{
+		Mapping: Mapping{
+			Status: "status",
+		},
+	}
+
+	p, err := newParser(header, opts, []lists.MergeField{{Tag: "FNAME", Required: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		record []string
+		err    bool
+	}{
+		{[]string{"user@example.com", "", "John"}, false},
+		{[]string{"user@example", "", "John"}, true},
+		{[]string{"user@example.com", "active", "John"}, true},
+		{[]string{"user@example.com", "", ""}, true},
+		{[]string{"user@example.com", ""}, true},
+	}
+
+	for i, tt := range tests {
+		_, err := p.parse(tt.record)
+		if (err != nil) != tt.err {
+			t.Errorf("%d. Expected error to be %v, got %v", i, tt.err, err)
+		}
+	}
+
+	for _, status := range []string{"cleaned", "archived", "transactional"} {
+		if _, err := p.parse([]string{"user@example.com", status, "John"}); err != ErrInvalidStatus {
+			t.Errorf("Expected status %s to return ErrInvalidStatus, got %v", status, err)
+		}
+	}
+}
+
+func TestParseValidator(t *testing.T) {
+	opts := &Options{
+		Validator: &members.Validator{Blocklists: []members.Blocklist{members.DefaultRoleBlocklist}},
+	}
+
+	p, err := newParser([]string{"email"}, opts, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = p.parse([]string{"admin@example.com"})
+	if ve, ok := err.(*members.ValidationError); !ok || ve.Reason != members.ReasonRole {
+		t.Errorf("Expected a *members.ValidationError with reason %s, got %v", members.ReasonRole, err)
+	}
+}
+
+func TestNewParser(t *testing.T) {
+	if _, err := newParser([]string{"name"}, &Options{}, mergeFields); err != ErrNoEmailColumn {
+		t.Errorf("Expected to get ErrNoEmailColumn, got %v", err)
+	}
+
+	opts := &Options{
+		Mapping: Mapping{
+			MergeFields: map[string]string{"Company": "COMPANY"},
+		},
+	}
+	if _, err := newParser([]string{"email", "Company"}, opts, mergeFields); err == nil {
+		t.Error("Expected an error for an unknown merge field tag")
+	}
+}
+
+func TestUpsertParams(t *testing.T) {
+	header := []string{"email", "status"}
+
+	p, err := newParser(header, &Options{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row, err := p.parse([]string{"user@example.com", "subscribed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params := upsertParams(row)
+	if params.Status != "" || params.StatusIfNew != members.StatusPending {
+		t.Errorf("Expected only StatusIfNew to be set to %s without a status column, got %+v", members.StatusPending, params)
+	}
+
+	p, err = newParser(header, &Options{Mapping: Mapping{Status: "status"}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row, err = p.parse([]string{"user@example.com", "subscribed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params = upsertParams(row)
+	if params.Status != members.StatusSubscribed || params.StatusIfNew != members.StatusSubscribed {
+		t.Errorf("Expected Status and StatusIfNew to be set to %s, got %+v", members.StatusSubscribed, params)
+	}
+
+	row, err = p.parse([]string{"user@example.com", ""})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params = upsertParams(row)
+	if params.Status != "" {
+		t.Errorf("Expected Status to be empty for an empty status cell, got %s", params.Status)
+	}
+}
+
+func TestParseAllDuplicate(t *testing.T) {
+	data := "email,FNAME\nuser@example.com,John\ninvalid,Jane\nUser@Example.com,Johnny\nother@example.com,Joe\n"
+	reader := csv.NewReader(strings.NewReader(data))
+
+	header, err := reader.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := newParser(header, &Options{}, mergeFields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, rows, valid, err := p.parseAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 4 {
+		t.Fatalf("Expected 4 results, got %d", len(results))
+	}
+	if _, ok := results[1].Err.(*members.ValidationError); !ok {
+		t.Errorf("Expected row 3 to fail with a *members.ValidationError, got %v", results[1].Err)
+	}
+	if results[2].Action != ActionFailed || results[2].Err != ErrDuplicateEmail {
+		t.Errorf("Expected row 4 to fail with ErrDuplicateEmail, got %s %v", results[2].Action, results[2].Err)
+	}
+	if results[2].Row != 4 {
+		t.Errorf("Expected duplicate to be reported on row 4, got %d", results[2].Row)
+	}
+
+	if len(rows) != 2 || len(valid) != 2 {
+		t.Fatalf("Expected 2 valid rows, got %d", len(rows))
+	}
+	if valid[0] != 0 || valid[1] != 3 {
+		t.Errorf("Expected valid rows to map to results 0 and 3, got %v", valid)
+	}
+	if rows[0].params.MergeFields["FNAME"] != "John" {
+		t.Errorf("Expected the first row to be kept, got %v", rows[0].params.MergeFields)
+	}
+}
+
+func TestMapBatchReport(t *testing.T) {
+	results := []RowResult{
+		{Row: 2, EmailAddress: "new@example.com"},
+		{Row: 3, EmailAddress: "Updated@example.com"},
+		{Row: 4, EmailAddress: "error@example.com"},
+		{Row: 5, EmailAddress: "skipped@example.com"},
+	}
+	byEmail := map[string]*RowResult{
+		"new@example.com":     &results[0],
+		"updated@example.com": &results[1],
+		"error@example.com":   &results[2],
+		"skipped@example.com": &results[3],
+	}
+
+	report := &members.BatchReport{
+		NewMembers:     []members.Member{{EmailAddress: "new@example.com"}},
+		UpdatedMembers: []members.Member{{EmailAddress: "updated@example.com"}},
+		Errors:         []members.BatchError{{EmailAddress: "error@example.com", Error: "Invalid address"}},
+	}
+
+	mapBatchReport(report, nil, byEmail)
+
+	expected := []Action{ActionCreated, ActionUpdated, ActionFailed, ActionSkipped}
+	for i, action := range expected {
+		if results[i].Action != action {
+			t.Errorf("Expected row %d action to equal %s, got %s", results[i].Row, action, results[i].Action)
+		}
+	}
+	if results[2].Err == nil || results[2].Err.Error() != "Invalid address" {
+		t.Errorf("Expected row 4 error to equal \"Invalid address\", got %v", results[2].Err)
+	}
+
+	results[3] = RowResult{Row: 5, EmailAddress: "skipped@example.com"}
+	errRequest := errors.New("request failed")
+	mapBatchReport(&members.BatchReport{}, errRequest, map[string]*RowResult{"skipped@example.com": &results[3]})
+
+	if results[3].Action != ActionFailed || results[3].Err != errRequest {
+		t.Errorf("Expected rows of a failed request to fail, got %s %v", results[3].Action, results[3].Err)
+	}
+}
+
+func TestApplyTags(t *testing.T) {
+	rows := []*row{
+		{params: &members.NewParams{EmailAddress: "tagged@example.com"}, tags: []string{"vip"}},
+		{params: &members.NewParams{EmailAddress: "untagged@example.com"}},
+	}
+	results := []RowResult{
+		{Row: 2, EmailAddress: "tagged@example.com", Action: ActionUpserted},
+		{Row: 3, EmailAddress: "untagged@example.com", Action: ActionUpserted},
+	}
+
+	// No API key is set, so updating the tags fails without making a
+	// request.
+	applyTags("list", rows, []int{0, 1}, results)
+
+	if results[0].Action != ActionUpserted || results[0].Err != nil {
+		t.Errorf("Expected row 2 to keep its action, got %s %v", results[0].Action, results[0].Err)
+	}
+	if results[0].TagErr != mailchimp.ErrAPIKeyNotSet {
+		t.Errorf("Expected row 2 tag error to equal %v, got %v", mailchimp.ErrAPIKeyNotSet, results[0].TagErr)
+	}
+	if results[1].TagErr != nil {
+		t.Errorf("Expected row 3 to have no tag error, got %v", results[1].TagErr)
+	}
+}
@@ -1,7 +1,9 @@
 package members
 
 import (
+	"crypto/md5"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -10,13 +12,17 @@ import (
 	"github.com/beeker1121/mailchimp-go/query"
 )
 
+// ErrNoMembers is returned by GetMarketingPermissions when the list
+// has no members to read the marketing permissions from.
+var ErrNoMembers = errors.New("members: List has no members")
+
 // EmailType defines the type of email a member asked to get.
 type EmailType string
 
 // The email type definitions.
 const (
 	EmailTypeHTML EmailType = "html"
-	EmailTypeText           = "text"
+	EmailTypeText EmailType = "text"
 )
 
 // Status defines the subscription status for a given member
@@ -24,11 +30,27 @@ const (
 type Status string
 
 // The subscription status definitions.
+//
+// The cleaned status is set by MailChimp for addresses that bounced,
+// and the archived status is set when a member is deleted via the
+// Delete function. Neither can be requested through the Update
+// function.
 const (
-	StatusSubscribed   Status = "subscribed"
-	StatusUnsubscribed        = "unsubscribed"
-	StatusCleaned             = "cleaned"
-	StatusPending             = "pending"
+	StatusSubscribed    Status = "subscribed"
+	StatusUnsubscribed  Status = "unsubscribed"
+	StatusCleaned       Status = "cleaned"
+	StatusPending       Status = "pending"
+	StatusTransactional Status = "transactional"
+	StatusArchived      Status = "archived"
+)
+
+// SortDir defines the direction used to sort results.
+type SortDir string
+
+// The sort direction definitions.
+const (
+	SortDirAsc  SortDir = "ASC"
+	SortDirDesc SortDir = "DESC"
 )
 
 // Stats defines the open and click rates for a member.
@@ -48,11 +70,19 @@ type Location struct {
 }
 
 // Note defines a note about a member.
+//
+// The NoteID field is set when the note is returned as the last note
+// of a member, while the ID field is set when the note is returned by
+// the member notes functions.
 type Note struct {
-	NoteID    int       `json:"note_id"`
+	ID        int       `json:"id,omitempty"`
+	NoteID    int       `json:"note_id,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 	CreatedBy string    `json:"created_by"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	Note      string    `json:"note"`
+	ListID    string    `json:"list_id,omitempty"`
+	EmailID   string    `json:"email_id,omitempty"`
 }
 
 // UnmarshalJSON handles custom JSON unmarshalling for the Note object.
@@ -64,6 +94,7 @@ func (n *Note) UnmarshalJSON(data []byte) error {
 	aux := &struct {
 		*alias
 		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at,omitempty"`
 	}{
 		alias: (*alias)(n),
 	}
@@ -77,32 +108,53 @@ func (n *Note) UnmarshalJSON(data []byte) error {
 			return err
 		}
 	}
+	if aux.UpdatedAt != "" {
+		if n.UpdatedAt, err = time.Parse(time.RFC3339, aux.UpdatedAt); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+// MarketingPermission defines a GDPR marketing permission of a member,
+// such as consent to be contacted by email.
+type MarketingPermission struct {
+	MarketingPermissionID string `json:"marketing_permission_id"`
+	Text                  string `json:"text,omitempty"`
+	Enabled               bool   `json:"enabled"`
+}
+
+// Tag defines a tag assigned to a member.
+type Tag struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
 // Member defines a single member within a list.
 type Member struct {
-	ID              string                 `json:"id"`
-	EmailAddress    string                 `json:"email_address"`
-	UniqueEmailID   string                 `json:"unique_email_id"`
-	EmailType       EmailType              `json:"email_type,omitempty"`
-	Status          Status                 `json:"status"`
-	MergeFields     map[string]interface{} `json:"merge_fields,omitempty"`
-	Interests       map[string]bool        `json:"interests,omitempty"`
-	Stats           *Stats                 `json:"stats,omitempty"`
-	IPSignup        string                 `json:"ip_signup,omitempty"`
-	TimestampSignup time.Time              `json:"timestamp_signup,omitempty"`
-	IPOpt           string                 `json:"ip_opt,omitempty"`
-	TimestampOpt    time.Time              `json:"timestamp_opt,omitempty"`
-	MemberRating    uint8                  `json:"member_rating,omitempty"`
-	LastChanged     time.Time              `json:"last_changed,omitempty"`
-	Language        string                 `json:"language,omitempty"`
-	VIP             bool                   `json:"vip,omitempty"`
-	EmailClient     string                 `json:"email_client,omitempty"`
-	Location        *Location              `json:"location,omitempty"`
-	LastNote        *Note                  `json:"last_note,omitempty"`
-	ListID          string                 `json:"list_id"`
+	ID                   string                 `json:"id"`
+	EmailAddress         string                 `json:"email_address"`
+	UniqueEmailID        string                 `json:"unique_email_id"`
+	EmailType            EmailType              `json:"email_type,omitempty"`
+	Status               Status                 `json:"status"`
+	MergeFields          map[string]interface{} `json:"merge_fields,omitempty"`
+	Interests            map[string]bool        `json:"interests,omitempty"`
+	Stats                *Stats                 `json:"stats,omitempty"`
+	IPSignup             string                 `json:"ip_signup,omitempty"`
+	TimestampSignup      time.Time              `json:"timestamp_signup,omitempty"`
+	IPOpt                string                 `json:"ip_opt,omitempty"`
+	TimestampOpt         time.Time              `json:"timestamp_opt,omitempty"`
+	MemberRating         uint8                  `json:"member_rating,omitempty"`
+	LastChanged          time.Time              `json:"last_changed,omitempty"`
+	Language             string                 `json:"language,omitempty"`
+	VIP                  bool                   `json:"vip,omitempty"`
+	EmailClient          string                 `json:"email_client,omitempty"`
+	Location             *Location              `json:"location,omitempty"`
+	LastNote             *Note                  `json:"last_note,omitempty"`
+	ListID               string                 `json:"list_id"`
+	MarketingPermissions []MarketingPermission  `json:"marketing_permissions,omitempty"`
+	Tags                 []Tag                  `json:"tags,omitempty"`
 }
 
 // UnmarshalJSON handles custom JSON unmarshalling for the Member object.
@@ -153,18 +205,19 @@ type ListMembers struct {
 // NewParams defines the available parameters that can be used when
 // adding a new list member via the New function.
 type NewParams struct {
-	EmailType       EmailType              `json:"email_type,omitempty"`
-	Status          Status                 `json:"status"`
-	MergeFields     map[string]interface{} `json:"merge_fields,omitempty"`
-	Interests       map[string]bool        `json:"interests,omitempty"`
-	Language        string                 `json:"language,omitempty"`
-	VIP             bool                   `json:"vip,omitempty"`
-	Location        *Location              `json:"location,omitempty"`
-	IPSignup        string                 `json:"ip_signup,omitempty"`
-	TimestampSignup time.Time              `json:"timestamp_signup,omitempty"`
-	IPOpt           string                 `json:"ip_opt,omitempty"`
-	TimestampOpt    time.Time              `json:"timestamp_opt,omitempty"`
-	EmailAddress    string                 `json:"email_address"`
+	EmailType            EmailType              `json:"email_type,omitempty"`
+	Status               Status                 `json:"status"`
+	MergeFields          map[string]interface{} `json:"merge_fields,omitempty"`
+	Interests            map[string]bool        `json:"interests,omitempty"`
+	Language             string                 `json:"language,omitempty"`
+	VIP                  bool                   `json:"vip,omitempty"`
+	Location             *Location              `json:"location,omitempty"`
+	IPSignup             string                 `json:"ip_signup,omitempty"`
+	TimestampSignup      time.Time              `json:"timestamp_signup,omitempty"`
+	IPOpt                string                 `json:"ip_opt,omitempty"`
+	TimestampOpt         time.Time              `json:"timestamp_opt,omitempty"`
+	EmailAddress         string                 `json:"email_address"`
+	MarketingPermissions []MarketingPermission  `json:"marketing_permissions,omitempty"`
 }
 
 // MarshalJSON handles custom JSON marshalling for the NewParams object.
@@ -192,21 +245,54 @@ func (np *NewParams) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// InterestMatch defines how members are matched against the given
+// interest IDs.
+type InterestMatch string
+
+// The interest match definitions.
+const (
+	InterestMatchAny  InterestMatch = "any"
+	InterestMatchAll  InterestMatch = "all"
+	InterestMatchNone InterestMatch = "none"
+)
+
+// SortField defines the field used to sort list members.
+type SortField string
+
+// The sort field definitions.
+const (
+	SortFieldTimestampOpt    SortField = "timestamp_opt"
+	SortFieldTimestampSignup SortField = "timestamp_signup"
+	SortFieldLastChanged     SortField = "last_changed"
+)
+
+// MaxCount is the maximum number of members the API returns per
+// request.
+const MaxCount = 1000
+
 // GetParams defines the available parameters that can be used when
 // getting a list of members via the Get function.
 type GetParams struct {
-	Fields             []string  `url:"fields,omitempty"`
-	ExcludeFields      []string  `url:"exclude_fields,omitempty"`
-	Count              int       `url:"count,omitempty"`
-	Offset             int       `url:"offset,omitempty"`
-	EmailType          EmailType `url:"email_type,omitempty"`
-	Status             Status    `url:"status,omitempty"`
-	SinceTimestampOpt  time.Time `url:"since_timestamp_opt,omitempty"`
-	BeforeTimestampOpt time.Time `url:"before_timestamp_opt,omitempty"`
-	SinceLastChanged   time.Time `url:"since_last_changed,omitempty"`
-	BeforeLastChanged  time.Time `url:"before_last_changed,omitempty"`
-	UniqueEmailID      string    `url:"unique_email_id,omitempty"`
-	VIPOnly            bool      `url:"vip_only,omitempty"`
+	Fields               []string      `url:"fields,omitempty"`
+	ExcludeFields        []string      `url:"exclude_fields,omitempty"`
+	Count                int           `url:"count,omitempty"`
+	Offset               int           `url:"offset,omitempty"`
+	EmailType            EmailType     `url:"email_type,omitempty"`
+	Status               Status        `url:"status,omitempty"`
+	SinceTimestampOpt    time.Time     `url:"since_timestamp_opt,omitempty"`
+	BeforeTimestampOpt   time.Time     `url:"before_timestamp_opt,omitempty"`
+	SinceTimestampSignup time.Time     `url:"since_timestamp_signup,omitempty"`
+	SinceLastChanged     time.Time     `url:"since_last_changed,omitempty"`
+	BeforeLastChanged    time.Time     `url:"before_last_changed,omitempty"`
+	UniqueEmailID        string        `url:"unique_email_id,omitempty"`
+	VIPOnly              bool          `url:"vip_only,omitempty"`
+	InterestCategoryID   string        `url:"interest_category_id,omitempty"`
+	InterestIDs          []string      `url:"interest_ids,omitempty"`
+	InterestMatch        InterestMatch `url:"interest_match,omitempty"`
+	SortField            SortField     `url:"sort_field,omitempty"`
+	SortDir              SortDir       `url:"sort_dir,omitempty"`
+	SinceLastCampaign    bool          `url:"since_last_campaign,omitempty"`
+	UnsubscribedSince    time.Time     `url:"unsubscribed_since,omitempty"`
 }
 
 // EncodeQueryString handles custom query string encoding for the
@@ -214,8 +300,10 @@ type GetParams struct {
 func (gp *GetParams) EncodeQueryString(v interface{}) (string, error) {
 	var sinceTimestampOpt string
 	var beforeTimestampOpt string
+	var sinceTimestampSignup string
 	var sinceLastChanged string
 	var beforeLastChanged string
+	var unsubscribedSince string
 
 	if !gp.SinceTimestampOpt.IsZero() {
 		sinceTimestampOpt = gp.SinceTimestampOpt.Format(time.RFC3339)
@@ -223,39 +311,61 @@ func (gp *GetParams) EncodeQueryString(v interface{}) (string, error) {
 	if !gp.BeforeTimestampOpt.IsZero() {
 		beforeTimestampOpt = gp.BeforeTimestampOpt.Format(time.RFC3339)
 	}
+	if !gp.SinceTimestampSignup.IsZero() {
+		sinceTimestampSignup = gp.SinceTimestampSignup.Format(time.RFC3339)
+	}
 	if !gp.SinceLastChanged.IsZero() {
 		sinceLastChanged = gp.SinceLastChanged.Format(time.RFC3339)
 	}
 	if !gp.BeforeLastChanged.IsZero() {
 		beforeLastChanged = gp.BeforeLastChanged.Format(time.RFC3339)
 	}
+	if !gp.UnsubscribedSince.IsZero() {
+		unsubscribedSince = gp.UnsubscribedSince.Format(time.RFC3339)
+	}
 
 	return query.Encode(struct {
-		Fields             string    `url:"fields,omitempty"`
-		ExcludeFields      string    `url:"exclude_fields,omitempty"`
-		Count              int       `url:"count,omitempty"`
-		Offset             int       `url:"offset,omitempty"`
-		EmailType          EmailType `url:"email_type,omitempty"`
-		Status             Status    `url:"status,omitempty"`
-		SinceTimestampOpt  string    `url:"since_timestamp_opt,omitempty"`
-		BeforeTimestampOpt string    `url:"before_timestamp_opt,omitempty"`
-		SinceLastChanged   string    `url:"since_last_changed,omitempty"`
-		BeforeLastChanged  string    `url:"before_last_changed,omitempty"`
-		UniqueEmailID      string    `url:"unique_email_id,omitempty"`
-		VIPOnly            bool      `url:"vip_only,omitempty"`
+		Fields               string        `url:"fields,omitempty"`
+		ExcludeFields        string        `url:"exclude_fields,omitempty"`
+		Count                int           `url:"count,omitempty"`
+		Offset               int           `url:"offset,omitempty"`
+		EmailType            EmailType     `url:"email_type,omitempty"`
+		Status               Status        `url:"status,omitempty"`
+		SinceTimestampOpt    string        `url:"since_timestamp_opt,omitempty"`
+		BeforeTimestampOpt   string        `url:"before_timestamp_opt,omitempty"`
+		SinceTimestampSignup string        `url:"since_timestamp_signup,omitempty"`
+		SinceLastChanged     string        `url:"since_last_changed,omitempty"`
+		BeforeLastChanged    string        `url:"before_last_changed,omitempty"`
+		UniqueEmailID        string        `url:"unique_email_id,omitempty"`
+		VIPOnly              bool          `url:"vip_only,omitempty"`
+		InterestCategoryID   string        `url:"interest_category_id,omitempty"`
+		InterestIDs          string        `url:"interest_ids,omitempty"`
+		InterestMatch        InterestMatch `url:"interest_match,omitempty"`
+		SortField            SortField     `url:"sort_field,omitempty"`
+		SortDir              SortDir       `url:"sort_dir,omitempty"`
+		SinceLastCampaign    bool          `url:"since_last_campaign,omitempty"`
+		UnsubscribedSince    string        `url:"unsubscribed_since,omitempty"`
 	}{
-		Fields:             strings.Join(gp.Fields, ","),
-		ExcludeFields:      strings.Join(gp.ExcludeFields, ","),
-		Count:              gp.Count,
-		Offset:             gp.Offset,
-		EmailType:          gp.EmailType,
-		Status:             gp.Status,
-		SinceTimestampOpt:  sinceTimestampOpt,
-		BeforeTimestampOpt: beforeTimestampOpt,
-		SinceLastChanged:   sinceLastChanged,
-		BeforeLastChanged:  beforeLastChanged,
-		UniqueEmailID:      gp.UniqueEmailID,
-		VIPOnly:            gp.VIPOnly,
+		Fields:               strings.Join(gp.Fields, ","),
+		ExcludeFields:        strings.Join(gp.ExcludeFields, ","),
+		Count:                gp.Count,
+		Offset:               gp.Offset,
+		EmailType:            gp.EmailType,
+		Status:               gp.Status,
+		SinceTimestampOpt:    sinceTimestampOpt,
+		BeforeTimestampOpt:   beforeTimestampOpt,
+		SinceTimestampSignup: sinceTimestampSignup,
+		SinceLastChanged:     sinceLastChanged,
+		BeforeLastChanged:    beforeLastChanged,
+		UniqueEmailID:        gp.UniqueEmailID,
+		VIPOnly:              gp.VIPOnly,
+		InterestCategoryID:   gp.InterestCategoryID,
+		InterestIDs:          strings.Join(gp.InterestIDs, ","),
+		InterestMatch:        gp.InterestMatch,
+		SortField:            gp.SortField,
+		SortDir:              gp.SortDir,
+		SinceLastCampaign:    gp.SinceLastCampaign,
+		UnsubscribedSince:    unsubscribedSince,
 	})
 }
 
@@ -282,19 +392,20 @@ func (gmp *GetMemberParams) EncodeQueryString(v interface{}) (string, error) {
 // UpdateParams defines the available parameters that can be used when
 // updating a list member via the Update function.
 type UpdateParams struct {
-	EmailType       EmailType              `json:"email_type,omitempty"`
-	Status          Status                 `json:"status,omitempty"`
-	MergeFields     map[string]interface{} `json:"merge_fields,omitempty"`
-	Interests       map[string]bool        `json:"interests,omitempty"`
-	Language        string                 `json:"language,omitempty"`
-	VIP             bool                   `json:"vip,omitempty"`
-	Location        *Location              `json:"location,omitempty"`
-	IPSignup        string                 `json:"ip_signup,omitempty"`
-	TimestampSignup time.Time              `json:"timestamp_signup,omitempty"`
-	IPOpt           string                 `json:"ip_opt,omitempty"`
-	TimestampOpt    time.Time              `json:"timestamp_opt,omitempty"`
-	EmailAddress    string                 `json:"email_address,omitempty"`
-	StatusIfNew     string                 `json:"status_if_new,omitempty"`
+	EmailType            EmailType              `json:"email_type,omitempty"`
+	Status               Status                 `json:"status,omitempty"`
+	MergeFields          map[string]interface{} `json:"merge_fields,omitempty"`
+	Interests            map[string]bool        `json:"interests,omitempty"`
+	Language             string                 `json:"language,omitempty"`
+	VIP                  bool                   `json:"vip,omitempty"`
+	Location             *Location              `json:"location,omitempty"`
+	IPSignup             string                 `json:"ip_signup,omitempty"`
+	TimestampSignup      time.Time              `json:"timestamp_signup,omitempty"`
+	IPOpt                string                 `json:"ip_opt,omitempty"`
+	TimestampOpt         time.Time              `json:"timestamp_opt,omitempty"`
+	EmailAddress         string                 `json:"email_address,omitempty"`
+	StatusIfNew          Status                 `json:"status_if_new,omitempty"`
+	MarketingPermissions []MarketingPermission  `json:"marketing_permissions,omitempty"`
 }
 
 // MarshalJSON handles custom JSON marshalling for the UpdateParams object.
@@ -322,11 +433,31 @@ func (up *UpdateParams) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// Hash returns the subscriber hash of the given email address, which
+// is used to identify a member in the API paths.
+func Hash(email string) string {
+	return fmt.Sprintf("%x", md5.Sum([]byte(strings.ToLower(email))))
+}
+
 // New adds a new list member.
+//
+// If a Validator was set via SetValidator, the email address is
+// validated and normalized before the request is made.
 func New(listID string, params *NewParams) (*Member, error) {
 	res := &Member{}
 	path := fmt.Sprintf("lists/%s/members", listID)
 
+	if params != nil {
+		email, err := normalizeEmail(params.EmailAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		p := *params
+		p.EmailAddress = email
+		params = &p
+	}
+
 	if params == nil {
 		if err := mailchimp.Call("POST", path, nil, nil, res); err != nil {
 			return nil, err
@@ -358,6 +489,42 @@ func Get(listID string, params *GetParams) (*ListMembers, error) {
 	return res, nil
 }
 
+// Each calls fn for every member in a list matching the given
+// parameters, retrieving the members page by page via the Get
+// function.
+//
+// The Count parameter sets the page size. It defaults to MaxCount and
+// is capped at MaxCount, as larger pages are truncated by the API. The
+// Offset parameter sets the offset of the first page.
+// Iteration stops at the first error returned by fn or the API.
+func Each(listID string, params *GetParams, fn func(*Member) error) error {
+	p := GetParams{}
+	if params != nil {
+		p = *params
+	}
+	if p.Count <= 0 || p.Count > MaxCount {
+		p.Count = MaxCount
+	}
+
+	for {
+		res, err := Get(listID, &p)
+		if err != nil {
+			return err
+		}
+
+		for i := range res.Members {
+			if err := fn(&res.Members[i]); err != nil {
+				return err
+			}
+		}
+
+		if len(res.Members) < p.Count {
+			return nil
+		}
+		p.Offset += len(res.Members)
+	}
+}
+
 // GetMember retrieves information about a specific member within a list.
 func GetMember(listID, hash string, params *GetMemberParams) (*Member, error) {
 	res := &Member{}
@@ -377,8 +544,19 @@ func GetMember(listID, hash string, params *GetMemberParams) (*Member, error) {
 }
 
 // Update updates a list member.
+//
+// If a Validator was set via SetValidator and an email address is
+// given, it is validated and normalized before the request is made.
+// When the hash is the subscriber hash of the email address as given,
+// it is replaced by the hash of the normalized address so both still
+// refer to the same member.
 func Update(listID, hash string, params *UpdateParams) (*Member, error) {
 	res := &Member{}
+
+	hash, params, err := normalizeUpdate(hash, params)
+	if err != nil {
+		return nil, err
+	}
 	path := fmt.Sprintf("lists/%s/members/%s", listID, hash)
 
 	if params == nil {
@@ -394,8 +572,92 @@ func Update(listID, hash string, params *UpdateParams) (*Member, error) {
 	return res, nil
 }
 
-// Delete deletes a list member.
+// normalizeUpdate normalizes the email address of the given update
+// parameters, and the subscriber hash if it was derived from the email
+// address before normalization.
+func normalizeUpdate(hash string, params *UpdateParams) (string, *UpdateParams, error) {
+	if params == nil || params.EmailAddress == "" {
+		return hash, params, nil
+	}
+
+	email, err := normalizeEmail(params.EmailAddress)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if email != params.EmailAddress && strings.EqualFold(hash, Hash(params.EmailAddress)) {
+		hash = Hash(email)
+	}
+
+	p := *params
+	p.EmailAddress = email
+	return hash, &p, nil
+}
+
+// GetMarketingPermissions retrieves the marketing permissions enabled
+// on a list, which can be used to find the IDs needed to set member
+// permissions via the New and Update functions.
+//
+// The API only exposes marketing permissions through members, so the
+// list must contain at least one member, otherwise ErrNoMembers is
+// returned. The Enabled field of the returned permissions is always
+// false.
+func GetMarketingPermissions(listID string) ([]MarketingPermission, error) {
+	params := &GetParams{
+		Fields: []string{"members.marketing_permissions"},
+		Count:  1,
+	}
+
+	res, err := Get(listID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Members) == 0 {
+		return nil, ErrNoMembers
+	}
+
+	perms := res.Members[0].MarketingPermissions
+	for i := range perms {
+		perms[i].Enabled = false
+	}
+	return perms, nil
+}
+
+// Delete archives a list member.
+//
+// Archived members keep their history and can be added back to the
+// list later on via the New or Update functions. To remove a member
+// and all of their personal data, use the DeletePermanent function.
 func Delete(listID, hash string) error {
 	path := fmt.Sprintf("lists/%s/members/%s", listID, hash)
 	return mailchimp.Call("DELETE", path, nil, nil, nil)
 }
+
+// DeletePermanent permanently deletes a list member and all of their
+// personal data, as required by GDPR erasure requests.
+//
+// This action cannot be undone. The email address is marked as
+// forgotten, and any later attempt to add it back to the list via the
+// API will fail with an error for which IsForgottenEmail returns true.
+// The contact can only rejoin the list by signing up again themselves.
+func DeletePermanent(listID, hash string) error {
+	path := fmt.Sprintf("lists/%s/members/%s/actions/delete-permanent", listID, hash)
+	return mailchimp.Call("POST", path, nil, nil, nil)
+}
+
+// forgottenEmailTitle is the API error title returned when trying to
+// add a member that has been permanently deleted.
+const forgottenEmailTitle = "Forgotten Email Not Subscribed"
+
+// IsForgottenEmail reports whether err is the API error returned when
+// trying to add or update a member that was permanently deleted via
+// the DeletePermanent function.
+func IsForgottenEmail(err error) bool {
+	apiErr, ok := err.(*mailchimp.APIError)
+	if !ok {
+		return false
+	}
+
+	return apiErr.Title == forgottenEmailTitle
+}
@@ -4,8 +4,12 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net/http"
 	"os"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -36,6 +40,331 @@ func TestMemberUnmarshal(t *testing.T) {
 	}
 }
 
+func TestNoteUnmarshal(t *testing.T) {
+	data := []byte(`{
+		"id": 1,
+		"created_at": "2020-01-02T23:59:59+00:00",
+		"updated_at": "2020-01-02T23:59:59+00:00",
+		"note": "Called about billing"
+	}`)
+
+	note := &Note{}
+	if err := json.NewDecoder(bytes.NewBuffer(data)).Decode(note); err != nil {
+		t.Error(err)
+	}
+
+	if note.CreatedAt.String() != timeString {
+		t.Errorf("Expected note.CreatedAt.String() to equal %s, got %s", timeString, note.CreatedAt.String())
+	}
+	if note.UpdatedAt.String() != timeString {
+		t.Errorf("Expected note.UpdatedAt.String() to equal %s, got %s", timeString, note.UpdatedAt.String())
+	}
+}
+
+func TestActivityUnmarshal(t *testing.T) {
+	data := []byte(`{
+		"activity": [
+			{
+				"activity_type": "open",
+				"created_at_timestamp": "2020-01-02T23:59:59+00:00",
+				"campaign_id": "abc123"
+			}
+		],
+		"total_items": 1
+	}`)
+
+	feed := &ActivityFeed{}
+	if err := json.NewDecoder(bytes.NewBuffer(data)).Decode(feed); err != nil {
+		t.Error(err)
+	}
+
+	if feed.Activity[0].ActivityType != ActivityTypeOpen {
+		t.Errorf("Expected feed.Activity[0].ActivityType to equal %s, got %s", ActivityTypeOpen, feed.Activity[0].ActivityType)
+	}
+	if feed.Activity[0].CreatedAtTimestamp.String() != timeString {
+		t.Errorf("Expected feed.Activity[0].CreatedAtTimestamp.String() to equal %s, got %s", timeString, feed.Activity[0].CreatedAtTimestamp.String())
+	}
+}
+
+func TestGetActivityFeedParamsEncode(t *testing.T) {
+	params := &GetActivityFeedParams{
+		Count:           10,
+		ActivityFilters: []ActivityType{ActivityTypeOpen, ActivityTypeClick},
+	}
+
+	q, err := params.EncodeQueryString(params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := "activity_filters=open%2Cclick&count=10"
+	if q != expected {
+		t.Errorf("Expected query string to equal %s, got %s", expected, q)
+	}
+}
+
+func TestNewEventParamsMarshal(t *testing.T) {
+	occurredAt, err := time.Parse(time.RFC3339, "2020-01-02T23:59:59+00:00")
+	if err != nil {
+		t.Error(err)
+	}
+
+	params := &NewEventParams{
+		Name:       "trial_started",
+		Properties: map[string]string{"plan": "pro"},
+		OccurredAt: occurredAt,
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := `{"name":"trial_started","properties":{"plan":"pro"},"occurred_at":"2020-01-02T23:59:59Z"}`
+	if string(data) != expected {
+		t.Errorf("Expected params to marshal to %s, got %s", expected, string(data))
+	}
+}
+
+func TestMarketingPermissionsUnmarshal(t *testing.T) {
+	data := []byte(`{
+		"marketing_permissions": [
+			{
+				"marketing_permission_id": "abc123",
+				"text": "Email",
+				"enabled": true
+			}
+		]
+	}`)
+
+	member := &Member{}
+	if err := json.NewDecoder(bytes.NewBuffer(data)).Decode(member); err != nil {
+		t.Error(err)
+	}
+
+	if len(member.MarketingPermissions) != 1 {
+		t.Fatalf("Expected member.MarketingPermissions to have 1 entry, got %d", len(member.MarketingPermissions))
+	}
+	if member.MarketingPermissions[0].MarketingPermissionID != "abc123" {
+		t.Errorf("Expected MarketingPermissionID to equal \"abc123\", got %s", member.MarketingPermissions[0].MarketingPermissionID)
+	}
+	if !member.MarketingPermissions[0].Enabled {
+		t.Error("Expected Enabled to be true")
+	}
+}
+
+func TestGetParamsEncode(t *testing.T) {
+	params := &GetParams{
+		InterestCategoryID: "abc",
+		InterestIDs:        []string{"1", "2"},
+		InterestMatch:      InterestMatchAll,
+		SortField:          SortFieldLastChanged,
+		SortDir:            SortDirDesc,
+		SinceLastCampaign:  true,
+	}
+
+	q, err := params.EncodeQueryString(params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := "interest_category_id=abc&interest_ids=1%2C2&interest_match=all&since_last_campaign=true&sort_dir=DESC&sort_field=last_changed"
+	if q != expected {
+		t.Errorf("Expected query string to equal %s, got %s", expected, q)
+	}
+}
+
+func TestHash(t *testing.T) {
+	expected := "b58996c504c5638798eb6b511e6f49af"
+	if hash := Hash("User@Example.com"); hash != expected {
+		t.Errorf("Expected Hash to return %s, got %s", expected, hash)
+	}
+}
+
+func TestMemoryCheckpointStore(t *testing.T) {
+	store := &MemoryCheckpointStore{}
+
+	cp, err := store.Load(listID)
+	if err != nil {
+		t.Error(err)
+	}
+	if cp != nil {
+		t.Error("Expected Load to return a nil checkpoint")
+	}
+
+	lastChanged, err := time.Parse(time.RFC3339, "2020-01-02T23:59:59+00:00")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if err = store.Save(listID, &Checkpoint{LastChanged: lastChanged, IDs: []string{"123"}}); err != nil {
+		t.Error(err)
+	}
+
+	cp, err = store.Load(listID)
+	if err != nil {
+		t.Error(err)
+	}
+	if cp.LastChanged.String() != timeString {
+		t.Errorf("Expected cp.LastChanged.String() to equal %s, got %s", timeString, cp.LastChanged.String())
+	}
+	if len(cp.IDs) != 1 || cp.IDs[0] != "123" {
+		t.Errorf("Expected cp.IDs to equal [123], got %v", cp.IDs)
+	}
+}
+
+func TestValidatorNormalize(t *testing.T) {
+	v := &Validator{
+		Blocklists: []Blocklist{
+			DefaultRoleBlocklist,
+			DomainBlocklist{"mailinator.com": true},
+		},
+	}
+
+	tests := []struct {
+		in     string
+		want   string
+		reason Reason
+	}{
+		{" User@Example.COM ", "user@example.com", ""},
+		{"user@Bücher.DE", "user@bücher.de", ""},
+		{"user@" + strings.Repeat("a", 61) + "ü.example", "user@" + strings.Repeat("a", 61) + "ü.example", ""},
+		{"user@" + strings.Repeat("a", 62) + "ü.example", "", ReasonDomain},
+		{"", "", ReasonEmpty},
+		{"user.example.com", "", ReasonSyntax},
+		{".user@example.com", "", ReasonLocalPart},
+		{"us..er@example.com", "", ReasonLocalPart},
+		{"user@example", "", ReasonDomain},
+		{"user@-example.com", "", ReasonDomain},
+		{"user@example.123", "", ReasonDomain},
+		{"admin@example.com", "", ReasonRole},
+		{"user@mailinator.com", "", ReasonDisposable},
+	}
+
+	for i, tt := range tests {
+		got, err := v.Normalize(tt.in)
+		if tt.reason == "" {
+			if err != nil {
+				t.Errorf("%d. Normalize(%q) returned error: %v", i, tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("%d. Normalize(%q) returned %s, want %s", i, tt.in, got, tt.want)
+			}
+			continue
+		}
+
+		ve, ok := err.(*ValidationError)
+		if !ok {
+			t.Errorf("%d. Normalize(%q) returned error %v, want *ValidationError", i, tt.in, err)
+			continue
+		}
+		if ve.Reason != tt.reason {
+			t.Errorf("%d. Normalize(%q) returned reason %s, want %s", i, tt.in, ve.Reason, tt.reason)
+		}
+	}
+}
+
+func TestSetValidator(t *testing.T) {
+	SetValidator(&Validator{})
+	defer SetValidator(nil)
+
+	_, err := New(listID, &NewParams{EmailAddress: "invalid", Status: StatusPending})
+	if _, ok := err.(*ValidationError); !ok {
+		t.Errorf("Expected New to return a *ValidationError, got %v", err)
+	}
+}
+
+func TestNormalizeUpdate(t *testing.T) {
+	SetValidator(&Validator{})
+	defer SetValidator(nil)
+
+	email := " User@Example.COM "
+	hash, params, err := normalizeUpdate(Hash(email), &UpdateParams{EmailAddress: email})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if params.EmailAddress != "user@example.com" {
+		t.Errorf("Expected params.EmailAddress to equal \"user@example.com\", got %s", params.EmailAddress)
+	}
+	if hash != Hash(params.EmailAddress) {
+		t.Errorf("Expected hash to equal the hash of the normalized address, got %s", hash)
+	}
+
+	other := Hash("other@example.com")
+	hash, _, err = normalizeUpdate(other, &UpdateParams{EmailAddress: email})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hash != other {
+		t.Errorf("Expected a hash of another address to be left unchanged, got %s", hash)
+	}
+}
+
+func TestValidateTransition(t *testing.T) {
+	tests := []struct {
+		from  Status
+		to    Status
+		valid bool
+	}{
+		{StatusPending, StatusSubscribed, true},
+		{StatusSubscribed, StatusUnsubscribed, true},
+		{StatusUnsubscribed, StatusPending, true},
+		{StatusArchived, StatusSubscribed, true},
+		{StatusSubscribed, StatusSubscribed, true},
+		{StatusUnsubscribed, StatusSubscribed, false},
+		{StatusSubscribed, StatusCleaned, false},
+		{StatusSubscribed, StatusArchived, false},
+		{StatusCleaned, StatusPending, false},
+		{StatusSubscribed, Status("active"), false},
+	}
+
+	for i, tt := range tests {
+		err := ValidateTransition(tt.from, tt.to)
+		if tt.valid && err != nil {
+			t.Errorf("%d. Expected transition from %s to %s to be valid, got %v", i, tt.from, tt.to, err)
+		}
+		if !tt.valid {
+			if _, ok := err.(*TransitionError); !ok {
+				t.Errorf("%d. Expected transition from %s to %s to return a *TransitionError, got %v", i, tt.from, tt.to, err)
+			}
+		}
+	}
+}
+
+func TestStatusRequestable(t *testing.T) {
+	tests := []struct {
+		status      Status
+		requestable bool
+	}{
+		{StatusSubscribed, true},
+		{StatusUnsubscribed, true},
+		{StatusPending, true},
+		{StatusCleaned, false},
+		{StatusArchived, false},
+		{Status("active"), false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.status.Requestable(); got != tt.requestable {
+			t.Errorf("Expected %s requestable to be %v, got %v", tt.status, tt.requestable, got)
+		}
+	}
+}
+
+func TestStatusParams(t *testing.T) {
+	member := &Member{EmailAddress: "user@example.com", Status: StatusUnsubscribed}
+
+	params := statusParams(member, StatusPending)
+	if params.EmailAddress != "user@example.com" {
+		t.Errorf("Expected params.EmailAddress to equal \"user@example.com\", got %s", params.EmailAddress)
+	}
+	if params.Status != StatusPending || params.StatusIfNew != StatusPending {
+		t.Errorf("Expected params.Status and params.StatusIfNew to equal %s, got %+v", StatusPending, params)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	params := &NewParams{
 		EmailAddress: "mailchimp-go-test@github.com",
@@ -69,6 +398,41 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestDeletePermanent(t *testing.T) {
+	params := &NewParams{
+		EmailAddress: "mailchimp-go-test-forget@github.com",
+		Status:       StatusPending,
+	}
+
+	member, err := New(listID, params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if err = DeletePermanent(listID, member.ID); err != nil {
+		t.Error(err)
+	}
+
+	_, err = New(listID, params)
+	if !IsForgottenEmail(err) {
+		t.Errorf("Expected IsForgottenEmail to return true, got err %v", err)
+	}
+}
+
+func TestIsForgottenEmail(t *testing.T) {
+	err := &mailchimp.APIError{
+		Status: 400,
+		Title:  "Forgotten Email Not Subscribed",
+	}
+
+	if !IsForgottenEmail(err) {
+		t.Error("Expected IsForgottenEmail to return true")
+	}
+	if IsForgottenEmail(mailchimp.ErrAPIKeyNotSet) {
+		t.Error("Expected IsForgottenEmail to return false")
+	}
+}
+
 func TestNew(t *testing.T) {
 	params := &NewParams{
 		EmailAddress: "mailchimp-go-test@github.com",
@@ -127,6 +491,109 @@ func TestGet(t *testing.T) {
 	}
 }
 
+func TestEach(t *testing.T) {
+	params := &NewParams{
+		EmailAddress: "mailchimp-go-test1@github.com",
+		Status:       StatusPending,
+	}
+
+	member1, err := New(listID, params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	params.EmailAddress = "mailchimp-go-test2@github.com"
+
+	member2, err := New(listID, params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	var count int
+	err = Each(listID, &GetParams{Count: 1}, func(m *Member) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Errorf("Expected Each to visit 2 members, got %d", count)
+	}
+
+	if err = Delete(listID, member1.ID); err != nil {
+		t.Error(err)
+	}
+	if err = Delete(listID, member2.ID); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPoller(t *testing.T) {
+	params := &NewParams{
+		EmailAddress: "mailchimp-go-test@github.com",
+		Status:       StatusPending,
+	}
+
+	member, err := New(listID, params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	poller := NewPoller(listID, 0, nil)
+
+	var count int
+	deliver := func(m *Member) error {
+		count++
+		return nil
+	}
+
+	if err = poller.Poll(deliver); err != nil {
+		t.Error(err)
+	}
+	if err = poller.Poll(deliver); err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Errorf("Expected the member to be delivered once, got %d", count)
+	}
+
+	if err = Delete(listID, member.ID); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestResubscribe(t *testing.T) {
+	params := &NewParams{
+		EmailAddress: "mailchimp-go-test@github.com",
+		Status:       StatusUnsubscribed,
+	}
+
+	member, err := New(listID, params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if _, err = UpdateStatus(listID, member.ID, StatusSubscribed); err == nil {
+		t.Error("Expected UpdateStatus to return an error")
+	}
+
+	resubscribed, err := Resubscribe(listID, member.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if resubscribed.Status != StatusPending {
+		t.Errorf("Expected resubscribed.Status to equal %s, got %s", StatusPending, resubscribed.Status)
+	}
+
+	if err = Delete(listID, member.ID); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestGetMember(t *testing.T) {
 	params := &NewParams{
 		EmailAddress: "mailchimp-go-test@github.com",
@@ -194,6 +661,185 @@ func TestUpdateMember(t *testing.T) {
 	}
 }
 
+func TestNotes(t *testing.T) {
+	params := &NewParams{
+		EmailAddress: "mailchimp-go-test@github.com",
+		Status:       StatusPending,
+	}
+
+	member, err := New(listID, params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	note, err := NewNote(listID, member.ID, &NewNoteParams{Note: "mailchimp-go test note"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	updatedNote, err := UpdateNote(listID, member.ID, note.ID, &UpdateNoteParams{Note: "mailchimp-go updated note"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	gotNote, err := GetNote(listID, member.ID, note.ID, nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gotNote.Note != updatedNote.Note {
+		t.Errorf("Expected gotNote.Note to equal %s, got %s", updatedNote.Note, gotNote.Note)
+	}
+
+	notes, err := GetNotes(listID, member.ID, nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if notes.TotalItems != 1 {
+		t.Errorf("Expected notes.TotalItems to equal 1, got %d", notes.TotalItems)
+	}
+
+	if err = DeleteNote(listID, member.ID, note.ID); err != nil {
+		t.Error(err)
+	}
+
+	if err = Delete(listID, member.ID); err != nil {
+		t.Error(err)
+	}
+}
+
+// roundTripFunc allows a function to be used as an http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip satisfies the http.RoundTripper interface method.
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// fakeMembersClient returns an http.Client serving a list of the given
+// number of members, each changed one second after the previous one.
+// Pages are capped at MaxCount members, like the API does.
+func fakeMembersClient(total int) *http.Client {
+	start := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	return &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		q := req.URL.Query()
+		count, _ := strconv.Atoi(q.Get("count"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		if count <= 0 || count > MaxCount {
+			count = MaxCount
+		}
+
+		var since time.Time
+		if v := q.Get("since_last_changed"); v != "" {
+			since, _ = time.Parse(time.RFC3339, v)
+		}
+
+		var matching []Member
+		for i := 0; i < total; i++ {
+			lastChanged := start.Add(time.Duration(i) * time.Second)
+			if lastChanged.Before(since) {
+				continue
+			}
+			matching = append(matching, Member{
+				ID:           strconv.Itoa(i),
+				EmailAddress: fmt.Sprintf("user%d@example.com", i),
+				LastChanged:  lastChanged,
+			})
+		}
+
+		res := &ListMembers{TotalItems: len(matching)}
+		for i := offset; i < len(matching) && i < offset+count; i++ {
+			res.Members = append(res.Members, matching[i])
+		}
+
+		body, err := json.Marshal(res)
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       ioutil.NopCloser(bytes.NewReader(body)),
+			Request:    req,
+		}, nil
+	})}
+}
+
+func TestEachMaxCount(t *testing.T) {
+	mailchimp.SetClient(fakeMembersClient(2500))
+	defer mailchimp.SetClient(&http.Client{})
+
+	var count int
+	err := Each("abc123", &GetParams{Count: 5000}, func(m *Member) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 2500 {
+		t.Errorf("Expected Each to visit 2500 members, got %d", count)
+	}
+}
+
+func TestGetMarketingPermissionsNoMembers(t *testing.T) {
+	mailchimp.SetClient(fakeMembersClient(0))
+	defer mailchimp.SetClient(&http.Client{})
+
+	if _, err := GetMarketingPermissions("abc123"); err != ErrNoMembers {
+		t.Errorf("Expected GetMarketingPermissions to return ErrNoMembers, got %v", err)
+	}
+}
+
+func TestPollerMaxCount(t *testing.T) {
+	mailchimp.SetClient(fakeMembersClient(2500))
+	defer mailchimp.SetClient(&http.Client{})
+
+	poller := NewPoller("abc123", 0, nil)
+	poller.Params = &GetParams{Count: 5000}
+
+	var count int
+	err := poller.Poll(func(m *Member) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 2500 {
+		t.Errorf("Expected the poller to deliver 2500 members, got %d", count)
+	}
+}
+
+func TestBatchSubscribe(t *testing.T) {
+	params := []*NewParams{
+		{EmailAddress: "mailchimp-go-batch1@github.com", Status: StatusPending},
+		{EmailAddress: "mailchimp-go-batch2@github.com", Status: StatusPending},
+	}
+
+	report, err := BatchSubscribe(listID, params, nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if report.TotalCreated != 2 {
+		t.Errorf("Expected report.TotalCreated to equal 2, got %d", report.TotalCreated)
+	}
+	if len(report.NewMembers) != 2 {
+		t.Errorf("Expected report.NewMembers to have 2 entries, got %d", len(report.NewMembers))
+	}
+
+	for _, p := range params {
+		if err = Delete(listID, Hash(p.EmailAddress)); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
 func createList() (*lists.List, error) {
 	listParams := &lists.NewParams{
 		Name: "mailchimp-go Test List",
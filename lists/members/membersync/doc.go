@@ -0,0 +1,8 @@
+// Package membersync implements syncing the members of a list with a
+// local source of truth.
+//
+// A plan of the adds, updates, unsubscribes and archives needed to make
+// the list match the desired member records is computed first. The
+// plan can be inspected as a dry run, and then applied with a bounded
+// number of concurrent requests.
+package membersync
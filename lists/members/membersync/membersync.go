@@ -0,0 +1,409 @@
+package membersync
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/beeker1121/mailchimp-go/lists/members"
+)
+
+// DefaultConcurrency is the default number of concurrent requests used
+// when applying a plan.
+const DefaultConcurrency = 4
+
+// Record defines the desired state of a single member.
+//
+// If Status is empty, new members are subscribed and the status of
+// existing members is left unchanged.
+type Record struct {
+	EmailAddress string
+	Status       members.Status
+	MergeFields  map[string]interface{}
+	Interests    map[string]bool
+}
+
+// RemovalPolicy defines what happens to list members that are not in
+// the desired records.
+type RemovalPolicy int
+
+// The removal policy definitions.
+const (
+	// RemoveNone leaves members not in the records untouched.
+	RemoveNone RemovalPolicy = iota
+
+	// RemoveUnsubscribe unsubscribes members not in the records.
+	RemoveUnsubscribe
+
+	// RemoveArchive archives members not in the records.
+	RemoveArchive
+)
+
+// Comparison defines which fields are compared to decide whether an
+// existing member needs to be updated. The status is compared when it
+// is set on the desired record.
+type Comparison struct {
+	// MergeFields are the merge field tags to compare. If nil, every
+	// merge field set on the desired record is compared.
+	MergeFields []string
+
+	// Interests compares the interests set on the desired record.
+	Interests bool
+}
+
+// Options defines the available options that can be used when syncing
+// a list.
+type Options struct {
+	Removal RemovalPolicy
+	Compare Comparison
+
+	// DryRun only computes the plan without applying it.
+	DryRun bool
+
+	// Concurrency is the maximum number of concurrent requests used
+	// when applying a plan. It defaults to DefaultConcurrency.
+	Concurrency int
+
+	// Validator validates and normalizes the email address of the
+	// records. It defaults to a members.Validator without blocklists.
+	Validator *members.Validator
+}
+
+// ChangeType defines the type of a change within a plan.
+type ChangeType string
+
+// The change type definitions.
+const (
+	ChangeAdd         ChangeType = "add"
+	ChangeUpdate      ChangeType = "update"
+	ChangeUnsubscribe ChangeType = "unsubscribe"
+	ChangeArchive     ChangeType = "archive"
+)
+
+// Change defines a single change needed to sync a list.
+type Change struct {
+	Type         ChangeType
+	EmailAddress string
+
+	// Record is the desired state of the member. It is nil for
+	// unsubscribes and archives.
+	Record *Record
+
+	// Current is the current state of the member. It is nil for
+	// adds.
+	Current *members.Member
+}
+
+// Plan defines the changes needed to sync a list.
+type Plan struct {
+	ListID  string
+	Changes []Change
+
+	// Rejected holds the records that were not planned, either because
+	// their email address is invalid, in which case the error is a
+	// *members.ValidationError, or because the status of the member
+	// cannot be moved to the desired status, as reported by
+	// members.ValidateTransition.
+	Rejected []Failure
+}
+
+// Count returns the number of changes of the given type.
+func (p *Plan) Count(t ChangeType) int {
+	var n int
+	for _, c := range p.Changes {
+		if c.Type == t {
+			n++
+		}
+	}
+	return n
+}
+
+// Failure defines a change that could not be applied.
+type Failure struct {
+	Change Change
+	Err    error
+}
+
+// Result defines the result of applying a plan.
+type Result struct {
+	Applied  int
+	Failures []Failure
+}
+
+// Sync syncs the members of a list with the given records.
+//
+// The plan is always returned. If the DryRun option is set, the plan
+// is not applied and the returned result is nil.
+//
+// Unsubscribed members are never subscribed directly. A record that
+// subscribes them moves them to the pending status instead, so they
+// confirm through double opt-in, and pending members are left to
+// confirm on their own.
+func Sync(listID string, records []Record, opts *Options) (*Plan, *Result, error) {
+	plan, err := NewPlan(listID, records, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts != nil && opts.DryRun {
+		return plan, nil, nil
+	}
+
+	return plan, Apply(plan, opts), nil
+}
+
+// NewPlan retrieves the current members of a list and computes the
+// changes needed to match the given records.
+func NewPlan(listID string, records []Record, opts *Options) (*Plan, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	var current []members.Member
+	err := members.Each(listID, nil, func(m *members.Member) error {
+		current = append(current, *m)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes, rejected := diff(records, current, opts)
+
+	return &Plan{
+		ListID:   listID,
+		Changes:  changes,
+		Rejected: rejected,
+	}, nil
+}
+
+// diff computes the changes needed to turn the current members into
+// the desired records, along with the records that were rejected due
+// to an invalid email address or status transition.
+func diff(records []Record, current []members.Member, opts *Options) ([]Change, []Failure) {
+	var changes []Change
+	var rejected []Failure
+
+	validator := opts.Validator
+	if validator == nil {
+		validator = &members.Validator{}
+	}
+
+	byEmail := make(map[string]*members.Member)
+	for i := range current {
+		byEmail[normalize(current[i].EmailAddress)] = &current[i]
+	}
+
+	desired := make(map[string]bool)
+	for i := range records {
+		r := new(Record)
+		*r = records[i]
+
+		email, err := validator.Normalize(r.EmailAddress)
+		if err != nil {
+			rejected = append(rejected, Failure{
+				Change: Change{Type: ChangeAdd, EmailAddress: r.EmailAddress, Record: r},
+				Err:    err,
+			})
+			continue
+		}
+		r.EmailAddress = email
+
+		if desired[email] {
+			continue
+		}
+		desired[email] = true
+
+		m, ok := byEmail[email]
+		if !ok {
+			changes = append(changes, Change{Type: ChangeAdd, EmailAddress: r.EmailAddress, Record: r})
+			continue
+		}
+
+		// The status of cleaned members cannot be changed.
+		if m.Status == members.StatusCleaned {
+			continue
+		}
+
+		switch {
+		case r.Status == members.StatusSubscribed && m.Status == members.StatusUnsubscribed:
+			r.Status = members.StatusPending
+		case r.Status == members.StatusSubscribed && m.Status == members.StatusPending:
+			r.Status = ""
+		}
+
+		if r.Status != "" {
+			if err := members.ValidateTransition(m.Status, r.Status); err != nil {
+				rejected = append(rejected, Failure{
+					Change: Change{Type: ChangeUpdate, EmailAddress: m.EmailAddress, Record: r, Current: m},
+					Err:    err,
+				})
+				continue
+			}
+		}
+
+		if differs(r, m, &opts.Compare) {
+			changes = append(changes, Change{Type: ChangeUpdate, EmailAddress: m.EmailAddress, Record: r, Current: m})
+		}
+	}
+
+	if opts.Removal == RemoveNone {
+		return changes, rejected
+	}
+
+	var removed []Change
+	for i := range current {
+		m := &current[i]
+		if desired[normalize(m.EmailAddress)] {
+			continue
+		}
+
+		switch opts.Removal {
+		case RemoveUnsubscribe:
+			if m.Status == members.StatusSubscribed || m.Status == members.StatusPending {
+				removed = append(removed, Change{Type: ChangeUnsubscribe, EmailAddress: m.EmailAddress, Current: m})
+			}
+		case RemoveArchive:
+			if m.Status == members.StatusArchived {
+				continue
+			}
+			removed = append(removed, Change{Type: ChangeArchive, EmailAddress: m.EmailAddress, Current: m})
+		}
+	}
+	sort.Sort(byEmailAddress(removed))
+
+	return append(changes, removed...), rejected
+}
+
+// normalize returns the form of a member email address used to match
+// it with the records, as normalized by a members.Validator. Addresses
+// of existing members are not validated.
+func normalize(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// differs reports whether the member differs from the record.
+func differs(r *Record, m *members.Member, c *Comparison) bool {
+	if r.Status != "" && r.Status != m.Status {
+		return true
+	}
+
+	tags := c.MergeFields
+	if tags == nil {
+		for tag := range r.MergeFields {
+			tags = append(tags, tag)
+		}
+	}
+	for _, tag := range tags {
+		if fmt.Sprint(r.MergeFields[tag]) != fmt.Sprint(m.MergeFields[tag]) {
+			return true
+		}
+	}
+
+	if c.Interests {
+		for id, v := range r.Interests {
+			if m.Interests[id] != v {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// byEmailAddress sorts changes by email address.
+type byEmailAddress []Change
+
+func (s byEmailAddress) Len() int           { return len(s) }
+func (s byEmailAddress) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byEmailAddress) Less(i, j int) bool { return s[i].EmailAddress < s[j].EmailAddress }
+
+// Apply applies the changes of a plan, using up to the configured
+// number of concurrent requests. Changes that fail are reported in the
+// result and do not stop the other changes from being applied.
+func Apply(plan *Plan, opts *Options) *Result {
+	concurrency := DefaultConcurrency
+	if opts != nil && opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+
+	res := &Result{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	changes := make(chan Change)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range changes {
+				err := apply(plan.ListID, c)
+
+				mu.Lock()
+				if err != nil {
+					res.Failures = append(res.Failures, Failure{Change: c, Err: err})
+				} else {
+					res.Applied++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, c := range plan.Changes {
+		changes <- c
+	}
+	close(changes)
+	wg.Wait()
+
+	return res
+}
+
+// updateParams returns the parameters used to add, update or
+// unsubscribe the member of a change.
+func updateParams(c Change) *members.UpdateParams {
+	// The address and status if new are always sent, so the request
+	// is valid even if the member was deleted since the plan was made.
+	if c.Type == ChangeUnsubscribe {
+		return &members.UpdateParams{
+			EmailAddress: c.EmailAddress,
+			Status:       members.StatusUnsubscribed,
+			StatusIfNew:  members.StatusUnsubscribed,
+		}
+	}
+
+	params := &members.UpdateParams{
+		EmailAddress: c.Record.EmailAddress,
+		StatusIfNew:  c.Record.Status,
+		MergeFields:  c.Record.MergeFields,
+		Interests:    c.Record.Interests,
+	}
+	if params.StatusIfNew == "" {
+		params.StatusIfNew = members.StatusSubscribed
+	}
+
+	// The status of existing members is only sent when the record
+	// sets it, so members keep their current status otherwise.
+	if c.Type == ChangeUpdate {
+		params.Status = c.Record.Status
+	}
+
+	return params
+}
+
+// apply applies a single change.
+func apply(listID string, c Change) error {
+	hash := members.Hash(c.EmailAddress)
+
+	switch c.Type {
+	case ChangeAdd, ChangeUpdate, ChangeUnsubscribe:
+		params := updateParams(c)
+		_, err := members.Update(listID, hash, params)
+		return err
+	case ChangeArchive:
+		return members.Delete(listID, hash)
+	}
+
+	return fmt.Errorf("membersync: Unknown change type %s", c.Type)
+}
@@ -0,0 +1,207 @@
+package membersync
+
+import (
+	"testing"
+
+	"github.com/beeker1121/mailchimp-go/lists/members"
+)
+
+var current = []members.Member{
+	{
+		EmailAddress: "same@example.com",
+		Status:       members.StatusSubscribed,
+		MergeFields:  map[string]interface{}{"FNAME": "John", "LNAME": "Doe"},
+		Interests:    map[string]bool{"abc123": true},
+	},
+	{
+		EmailAddress: "changed@example.com",
+		Status:       members.StatusSubscribed,
+		MergeFields:  map[string]interface{}{"FNAME": "Jane"},
+	},
+	{
+		EmailAddress: "removed@example.com",
+		Status:       members.StatusSubscribed,
+	},
+	{
+		EmailAddress: "cleaned@example.com",
+		Status:       members.StatusCleaned,
+	},
+}
+
+var records = []Record{
+	{
+		EmailAddress: "Same@Example.com",
+		MergeFields:  map[string]interface{}{"FNAME": "John"},
+		Interests:    map[string]bool{"abc123": false},
+	},
+	{
+		EmailAddress: "changed@example.com",
+		MergeFields:  map[string]interface{}{"FNAME": "Janet"},
+	},
+	{
+		EmailAddress: "new@example.com",
+	},
+	{
+		EmailAddress: "cleaned@example.com",
+	},
+}
+
+func TestDiff(t *testing.T) {
+	changes, rejected := diff(records, current, &Options{Removal: RemoveUnsubscribe})
+	if len(rejected) != 0 {
+		t.Errorf("Expected no rejected updates, got %+v", rejected)
+	}
+
+	expected := []struct {
+		typ   ChangeType
+		email string
+	}{
+		{ChangeUpdate, "changed@example.com"},
+		{ChangeAdd, "new@example.com"},
+		{ChangeUnsubscribe, "removed@example.com"},
+	}
+
+	if len(changes) != len(expected) {
+		t.Fatalf("Expected %d changes, got %d: %+v", len(expected), len(changes), changes)
+	}
+	for i, e := range expected {
+		if changes[i].Type != e.typ || changes[i].EmailAddress != e.email {
+			t.Errorf("%d. Expected %s %s, got %s %s", i, e.typ, e.email, changes[i].Type, changes[i].EmailAddress)
+		}
+	}
+
+	if records[2].Status != "" {
+		t.Error("Expected diff to leave the records untouched")
+	}
+}
+
+func TestDiffCompare(t *testing.T) {
+	opts := &Options{
+		Removal: RemoveArchive,
+		Compare: Comparison{
+			MergeFields: []string{},
+			Interests:   true,
+		},
+	}
+
+	changes, _ := diff(records, current, opts)
+	plan := &Plan{Changes: changes}
+
+	if plan.Count(ChangeUpdate) != 1 || plan.Changes[0].EmailAddress != "same@example.com" {
+		t.Errorf("Expected only same@example.com to be updated, got %+v", plan.Changes)
+	}
+	if plan.Count(ChangeArchive) != 1 {
+		t.Errorf("Expected 1 archive, got %d", plan.Count(ChangeArchive))
+	}
+	if plan.Count(ChangeAdd) != 1 {
+		t.Errorf("Expected 1 add, got %d", plan.Count(ChangeAdd))
+	}
+}
+
+func TestDiffStatus(t *testing.T) {
+	current := []members.Member{
+		{EmailAddress: "optout@example.com", Status: members.StatusUnsubscribed, MergeFields: map[string]interface{}{"FNAME": "John"}},
+		{EmailAddress: "resubscribe@example.com", Status: members.StatusUnsubscribed},
+		{EmailAddress: "pending@example.com", Status: members.StatusPending},
+		{EmailAddress: "cleaned@example.com", Status: members.StatusSubscribed},
+	}
+	records := []Record{
+		{EmailAddress: "optout@example.com", MergeFields: map[string]interface{}{"FNAME": "Johnny"}},
+		{EmailAddress: "resubscribe@example.com", Status: members.StatusSubscribed},
+		{EmailAddress: "pending@example.com", Status: members.StatusSubscribed},
+		{EmailAddress: "cleaned@example.com", Status: members.StatusCleaned},
+	}
+
+	changes, rejected := diff(records, current, &Options{})
+
+	if len(changes) != 2 {
+		t.Fatalf("Expected 2 changes, got %d: %+v", len(changes), changes)
+	}
+
+	params := updateParams(changes[0])
+	if changes[0].EmailAddress != "optout@example.com" || params.Status != "" {
+		t.Errorf("Expected optout@example.com to keep its status, got %+v", params)
+	}
+	if params.StatusIfNew != members.StatusSubscribed {
+		t.Errorf("Expected StatusIfNew to equal %s, got %s", members.StatusSubscribed, params.StatusIfNew)
+	}
+
+	params = updateParams(changes[1])
+	if changes[1].EmailAddress != "resubscribe@example.com" || params.Status != members.StatusPending {
+		t.Errorf("Expected resubscribe@example.com to be moved to %s, got %+v", members.StatusPending, params)
+	}
+
+	if len(rejected) != 1 || rejected[0].Change.EmailAddress != "cleaned@example.com" {
+		t.Fatalf("Expected cleaned@example.com to be rejected, got %+v", rejected)
+	}
+	if _, ok := rejected[0].Err.(*members.TransitionError); !ok {
+		t.Errorf("Expected rejected error to be a *members.TransitionError, got %T", rejected[0].Err)
+	}
+}
+
+func TestDiffArchived(t *testing.T) {
+	current := []members.Member{
+		{EmailAddress: "archived@example.com", Status: members.StatusArchived},
+		{EmailAddress: "removed@example.com", Status: members.StatusUnsubscribed},
+	}
+
+	changes, _ := diff(nil, current, &Options{Removal: RemoveArchive})
+
+	if len(changes) != 1 || changes[0].EmailAddress != "removed@example.com" {
+		t.Errorf("Expected only removed@example.com to be archived, got %+v", changes)
+	}
+}
+
+func TestUpdateParamsAdd(t *testing.T) {
+	params := updateParams(Change{
+		Type:         ChangeAdd,
+		EmailAddress: "new@example.com",
+		Record:       &Record{EmailAddress: "new@example.com"},
+	})
+
+	if params.Status != "" || params.StatusIfNew != members.StatusSubscribed {
+		t.Errorf("Expected only StatusIfNew to be set to %s, got %+v", members.StatusSubscribed, params)
+	}
+}
+
+func TestUpdateParamsUnsubscribe(t *testing.T) {
+	params := updateParams(Change{
+		Type:         ChangeUnsubscribe,
+		EmailAddress: "old@example.com",
+		Current:      &members.Member{EmailAddress: "old@example.com", Status: members.StatusSubscribed},
+	})
+
+	if params.EmailAddress != "old@example.com" {
+		t.Errorf("Expected EmailAddress to equal \"old@example.com\", got %s", params.EmailAddress)
+	}
+	if params.Status != members.StatusUnsubscribed || params.StatusIfNew != members.StatusUnsubscribed {
+		t.Errorf("Expected Status and StatusIfNew to equal %s, got %+v", members.StatusUnsubscribed, params)
+	}
+}
+
+func TestDiffNormalize(t *testing.T) {
+	current := []members.Member{
+		{EmailAddress: "User@Example.com", Status: members.StatusSubscribed},
+	}
+	records := []Record{
+		{EmailAddress: " user@example.COM "},
+		{EmailAddress: "New@Example.com"},
+		{EmailAddress: ""},
+		{EmailAddress: "invalid"},
+	}
+
+	changes, rejected := diff(records, current, &Options{Removal: RemoveArchive})
+
+	if len(changes) != 1 || changes[0].Type != ChangeAdd || changes[0].EmailAddress != "new@example.com" {
+		t.Errorf("Expected only an add of new@example.com, got %+v", changes)
+	}
+
+	if len(rejected) != 2 {
+		t.Fatalf("Expected 2 rejected records, got %+v", rejected)
+	}
+	for _, f := range rejected {
+		if _, ok := f.Err.(*members.ValidationError); !ok {
+			t.Errorf("Expected %q to be rejected with a *members.ValidationError, got %v", f.Change.EmailAddress, f.Err)
+		}
+	}
+}
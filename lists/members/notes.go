@@ -0,0 +1,166 @@
+package members
+
+import (
+	"fmt"
+	"strings"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+	"github.com/beeker1121/mailchimp-go/query"
+)
+
+// MemberNotes defines a list of notes about a member.
+type MemberNotes struct {
+	Notes      []Note `json:"notes,omitempty"`
+	EmailID    string `json:"email_id"`
+	ListID     string `json:"list_id"`
+	TotalItems int    `json:"total_items"`
+}
+
+// NoteSortField defines the field used to sort member notes.
+type NoteSortField string
+
+// The note sort field definitions.
+const (
+	NoteSortFieldCreatedAt NoteSortField = "created_at"
+	NoteSortFieldUpdatedAt NoteSortField = "updated_at"
+)
+
+// NewNoteParams defines the available parameters that can be used
+// when adding a new note to a member via the NewNote function.
+type NewNoteParams struct {
+	Note string `json:"note"`
+}
+
+// GetNotesParams defines the available parameters that can be used
+// when getting the notes of a member via the GetNotes function.
+type GetNotesParams struct {
+	Fields        []string      `url:"fields,omitempty"`
+	ExcludeFields []string      `url:"exclude_fields,omitempty"`
+	Count         int           `url:"count,omitempty"`
+	Offset        int           `url:"offset,omitempty"`
+	SortField     NoteSortField `url:"sort_field,omitempty"`
+	SortDir       SortDir       `url:"sort_dir,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetNotesParams object.
+func (gnp *GetNotesParams) EncodeQueryString(v interface{}) (string, error) {
+	return query.Encode(struct {
+		Fields        string        `url:"fields,omitempty"`
+		ExcludeFields string        `url:"exclude_fields,omitempty"`
+		Count         int           `url:"count,omitempty"`
+		Offset        int           `url:"offset,omitempty"`
+		SortField     NoteSortField `url:"sort_field,omitempty"`
+		SortDir       SortDir       `url:"sort_dir,omitempty"`
+	}{
+		Fields:        strings.Join(gnp.Fields, ","),
+		ExcludeFields: strings.Join(gnp.ExcludeFields, ","),
+		Count:         gnp.Count,
+		Offset:        gnp.Offset,
+		SortField:     gnp.SortField,
+		SortDir:       gnp.SortDir,
+	})
+}
+
+// GetNoteParams defines the available parameters that can be used
+// when getting a specific note via the GetNote function.
+type GetNoteParams struct {
+	Fields        []string `url:"fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetNoteParams object.
+func (gnp *GetNoteParams) EncodeQueryString(v interface{}) (string, error) {
+	return query.Encode(struct {
+		Fields        string `url:"fields,omitempty"`
+		ExcludeFields string `url:"exclude_fields,omitempty"`
+	}{
+		Fields:        strings.Join(gnp.Fields, ","),
+		ExcludeFields: strings.Join(gnp.ExcludeFields, ","),
+	})
+}
+
+// UpdateNoteParams defines the available parameters that can be used
+// when updating a note via the UpdateNote function.
+type UpdateNoteParams struct {
+	Note string `json:"note"`
+}
+
+// NewNote adds a new note to a list member.
+func NewNote(listID, hash string, params *NewNoteParams) (*Note, error) {
+	res := &Note{}
+	path := fmt.Sprintf("lists/%s/members/%s/notes", listID, hash)
+
+	if params == nil {
+		if err := mailchimp.Call("POST", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("POST", path, nil, params, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetNotes retrieves the notes of a list member.
+func GetNotes(listID, hash string, params *GetNotesParams) (*MemberNotes, error) {
+	res := &MemberNotes{}
+	path := fmt.Sprintf("lists/%s/members/%s/notes", listID, hash)
+
+	if params == nil {
+		if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", path, params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetNote retrieves a specific note of a list member.
+func GetNote(listID, hash string, noteID int, params *GetNoteParams) (*Note, error) {
+	res := &Note{}
+	path := fmt.Sprintf("lists/%s/members/%s/notes/%d", listID, hash, noteID)
+
+	if params == nil {
+		if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", path, params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UpdateNote updates a note of a list member.
+func UpdateNote(listID, hash string, noteID int, params *UpdateNoteParams) (*Note, error) {
+	res := &Note{}
+	path := fmt.Sprintf("lists/%s/members/%s/notes/%d", listID, hash, noteID)
+
+	if params == nil {
+		if err := mailchimp.Call("PATCH", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("PATCH", path, nil, params, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteNote deletes a note of a list member.
+func DeleteNote(listID, hash string, noteID int) error {
+	path := fmt.Sprintf("lists/%s/members/%s/notes/%d", listID, hash, noteID)
+	return mailchimp.Call("DELETE", path, nil, nil, nil)
+}
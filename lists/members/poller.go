@@ -0,0 +1,240 @@
+package members
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// DefaultPollInterval is the default interval between two polls of a
+// Poller.
+const DefaultPollInterval = time.Minute
+
+// errPollerStopped is used to abort a poll when the poller is stopped.
+var errPollerStopped = errors.New("members: Poller stopped")
+
+// Checkpoint defines the high-water mark of a Poller.
+type Checkpoint struct {
+	// LastChanged is the last changed time of the most recent member
+	// delivered.
+	LastChanged time.Time
+
+	// IDs are the IDs of the members delivered with a last changed
+	// time equal to LastChanged. They are used to skip these members
+	// when they are returned again by the next poll.
+	IDs []string
+}
+
+// CheckpointStore defines a store used by a Poller to persist its
+// checkpoint between polls and restarts.
+type CheckpointStore interface {
+	// Load returns the checkpoint of the given list, or nil if no
+	// checkpoint has been saved yet.
+	Load(listID string) (*Checkpoint, error)
+
+	// Save saves the checkpoint of the given list.
+	Save(listID string, cp *Checkpoint) error
+}
+
+// MemoryCheckpointStore is a CheckpointStore that keeps checkpoints in
+// memory. The zero value is ready to use.
+type MemoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]Checkpoint
+}
+
+// Load satisfies the CheckpointStore interface method.
+func (s *MemoryCheckpointStore) Load(listID string) (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cp, ok := s.checkpoints[listID]
+	if !ok {
+		return nil, nil
+	}
+
+	cp.IDs = append([]string(nil), cp.IDs...)
+	return &cp, nil
+}
+
+// Save satisfies the CheckpointStore interface method.
+func (s *MemoryCheckpointStore) Save(listID string, cp *Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.checkpoints == nil {
+		s.checkpoints = make(map[string]Checkpoint)
+	}
+
+	s.checkpoints[listID] = Checkpoint{
+		LastChanged: cp.LastChanged,
+		IDs:         append([]string(nil), cp.IDs...),
+	}
+	return nil
+}
+
+// Poller polls a list for members that changed since the last poll.
+type Poller struct {
+	listID   string
+	interval time.Duration
+	store    CheckpointStore
+
+	// Params sets extra filters used when getting the members, such
+	// as a status. The SinceLastChanged, SortField, SortDir and
+	// Offset parameters are set by the poller, and the Count parameter
+	// is capped at MaxCount.
+	Params *GetParams
+
+	stop chan struct{}
+	once sync.Once
+}
+
+// NewPoller creates a new Poller for the given list. If interval is
+// zero, DefaultPollInterval is used. If store is nil, checkpoints are
+// kept in memory.
+func NewPoller(listID string, interval time.Duration, store CheckpointStore) *Poller {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	if store == nil {
+		store = &MemoryCheckpointStore{}
+	}
+
+	return &Poller{
+		listID:   listID,
+		interval: interval,
+		store:    store,
+		stop:     make(chan struct{}),
+	}
+}
+
+// Poll retrieves the members that changed since the last checkpoint,
+// calls fn for each of them in order of their last changed time, and
+// saves the new checkpoint.
+//
+// If fn returns an error, the checkpoint of the members delivered so
+// far is saved and the error is returned.
+func (p *Poller) Poll(fn func(*Member) error) error {
+	cp, err := p.store.Load(p.listID)
+	if err != nil {
+		return err
+	}
+	if cp == nil {
+		cp = &Checkpoint{}
+	}
+
+	seen := make(map[string]bool)
+	for _, id := range cp.IDs {
+		seen[id] = true
+	}
+
+	params := GetParams{}
+	if p.Params != nil {
+		params = *p.Params
+	}
+	if params.Count <= 0 || params.Count > MaxCount {
+		params.Count = MaxCount
+	}
+	params.SortField = SortFieldLastChanged
+	params.SortDir = SortDirAsc
+	params.Offset = 0
+
+	for {
+		params.SinceLastChanged = cp.LastChanged
+
+		res, err := Get(p.listID, &params)
+		if err != nil {
+			return err
+		}
+
+		mark := cp.LastChanged
+		for i := range res.Members {
+			m := &res.Members[i]
+
+			// Skip members already delivered at the boundary.
+			if m.LastChanged.Before(cp.LastChanged) || (m.LastChanged.Equal(cp.LastChanged) && seen[m.ID]) {
+				continue
+			}
+
+			if err := fn(m); err != nil {
+				if saveErr := p.store.Save(p.listID, cp); saveErr != nil {
+					return saveErr
+				}
+				return err
+			}
+
+			if m.LastChanged.After(cp.LastChanged) {
+				cp = &Checkpoint{LastChanged: m.LastChanged}
+				seen = make(map[string]bool)
+			}
+			cp.IDs = append(cp.IDs, m.ID)
+			seen[m.ID] = true
+		}
+
+		if len(res.Members) < params.Count {
+			break
+		}
+
+		// Keep paging through members sharing the same last changed
+		// time, otherwise start again from the new checkpoint.
+		if cp.LastChanged.Equal(mark) {
+			params.Offset += len(res.Members)
+		} else {
+			params.Offset = 0
+		}
+	}
+
+	return p.store.Save(p.listID, cp)
+}
+
+// Run polls the list at every interval and calls fn for each changed
+// member, until Stop is called or an error occurs.
+func (p *Poller) Run(fn func(*Member) error) error {
+	for {
+		if err := p.Poll(fn); err != nil {
+			if err == errPollerStopped {
+				return nil
+			}
+			return err
+		}
+
+		select {
+		case <-p.stop:
+			return nil
+		case <-time.After(p.interval):
+		}
+	}
+}
+
+// Start runs the poller in a new goroutine and delivers the changed
+// members to the returned channel. When the poller ends, the error
+// that ended it, or nil if Stop was called, is sent to the error
+// channel and both channels are closed.
+func (p *Poller) Start() (<-chan Member, <-chan error) {
+	ch := make(chan Member)
+	errCh := make(chan error, 1)
+
+	go func() {
+		err := p.Run(func(m *Member) error {
+			select {
+			case ch <- *m:
+				return nil
+			case <-p.stop:
+				return errPollerStopped
+			}
+		})
+
+		errCh <- err
+		close(ch)
+		close(errCh)
+	}()
+
+	return ch, errCh
+}
+
+// Stop stops the poller.
+func (p *Poller) Stop() {
+	p.once.Do(func() {
+		close(p.stop)
+	})
+}
@@ -0,0 +1,111 @@
+package members
+
+import (
+	"fmt"
+)
+
+// TransitionError is returned when a status transition is not
+// allowed.
+type TransitionError struct {
+	From Status
+	To   Status
+}
+
+// Error satisfies the error interface method.
+func (te *TransitionError) Error() string {
+	if te.From == StatusUnsubscribed && te.To == StatusSubscribed {
+		return "members: Unsubscribed members must be resubscribed via the pending status"
+	}
+	return fmt.Sprintf("members: Invalid status transition from %s to %s", te.From, te.To)
+}
+
+// transitions defines the statuses a member can be moved to from each
+// status.
+var transitions = map[Status][]Status{
+	StatusSubscribed:    {StatusUnsubscribed, StatusPending},
+	StatusUnsubscribed:  {StatusPending},
+	StatusPending:       {StatusSubscribed, StatusUnsubscribed},
+	StatusTransactional: {StatusSubscribed, StatusUnsubscribed, StatusPending},
+	StatusArchived:      {StatusSubscribed, StatusUnsubscribed, StatusPending},
+	StatusCleaned:       {},
+}
+
+// Valid reports whether the status is a known status.
+func (s Status) Valid() bool {
+	_, ok := transitions[s]
+	return ok
+}
+
+// Requestable reports whether the status can be requested when adding
+// or updating a member, that is whether any status can be moved to it.
+// The cleaned and archived statuses are set by MailChimp and cannot be
+// requested.
+func (s Status) Requestable() bool {
+	for _, to := range transitions {
+		for _, t := range to {
+			if t == s {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ValidateTransition validates that a member can be moved from one
+// status to another via the Update function. Moving a member to its
+// current status is always allowed.
+//
+// Unsubscribed members cannot be subscribed directly, they must be
+// moved to the pending status so they confirm their subscription
+// through double opt-in. Cleaned members cannot be changed, and the
+// cleaned and archived statuses cannot be requested.
+func ValidateTransition(from, to Status) error {
+	if !from.Valid() || !to.Valid() {
+		return &TransitionError{From: from, To: to}
+	}
+	if from == to {
+		return nil
+	}
+
+	for _, s := range transitions[from] {
+		if s == to {
+			return nil
+		}
+	}
+
+	return &TransitionError{From: from, To: to}
+}
+
+// UpdateStatus moves a list member to the given status, after checking
+// the transition from its current status via ValidateTransition.
+func UpdateStatus(listID, hash string, status Status) (*Member, error) {
+	member, err := GetMember(listID, hash, &GetMemberParams{Fields: []string{"email_address", "status"}})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ValidateTransition(member.Status, status); err != nil {
+		return nil, err
+	}
+
+	return Update(listID, hash, statusParams(member, status))
+}
+
+// statusParams returns the parameters used by UpdateStatus to move a
+// member to the given status. The address and status if new are sent
+// along with the status, as required when the member was deleted since
+// it was retrieved.
+func statusParams(member *Member, status Status) *UpdateParams {
+	return &UpdateParams{
+		EmailAddress: member.EmailAddress,
+		Status:       status,
+		StatusIfNew:  status,
+	}
+}
+
+// Resubscribe resubscribes an unsubscribed or archived list member by
+// moving them to the pending status, which sends them a confirmation
+// email for double opt-in.
+func Resubscribe(listID, hash string) (*Member, error) {
+	return UpdateStatus(listID, hash, StatusPending)
+}
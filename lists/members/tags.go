@@ -0,0 +1,58 @@
+package members
+
+import (
+	"fmt"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+)
+
+// TagStatus defines whether a tag is added to or removed from a
+// member.
+type TagStatus string
+
+// The tag status definitions.
+const (
+	TagStatusActive   TagStatus = "active"
+	TagStatusInactive TagStatus = "inactive"
+)
+
+// TagUpdate defines a tag to add to or remove from a member.
+type TagUpdate struct {
+	Name   string    `json:"name"`
+	Status TagStatus `json:"status"`
+}
+
+// MemberTags defines a list of tags assigned to a member.
+type MemberTags struct {
+	Tags       []Tag `json:"tags,omitempty"`
+	TotalItems int   `json:"total_items"`
+}
+
+// UpdateTagsParams defines the available parameters that can be used
+// when updating the tags of a member via the UpdateTags function.
+type UpdateTagsParams struct {
+	Tags      []TagUpdate `json:"tags"`
+	IsSyncing bool        `json:"is_syncing,omitempty"`
+}
+
+// GetTags retrieves the tags of a list member.
+func GetTags(listID, hash string) (*MemberTags, error) {
+	res := &MemberTags{}
+	path := fmt.Sprintf("lists/%s/members/%s/tags", listID, hash)
+
+	if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UpdateTags adds or removes tags of a list member.
+func UpdateTags(listID, hash string, params *UpdateTagsParams) error {
+	path := fmt.Sprintf("lists/%s/members/%s/tags", listID, hash)
+
+	if params == nil {
+		return mailchimp.Call("POST", path, nil, nil, nil)
+	}
+
+	return mailchimp.Call("POST", path, nil, params, nil)
+}
@@ -0,0 +1,95 @@
+package lists
+
+import (
+	"fmt"
+	"strings"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+	"github.com/beeker1121/mailchimp-go/query"
+)
+
+// MergeFieldOptions defines the extra options of a merge field.
+type MergeFieldOptions struct {
+	DefaultCountry int      `json:"default_country,omitempty"`
+	PhoneFormat    string   `json:"phone_format,omitempty"`
+	DateFormat     string   `json:"date_format,omitempty"`
+	Choices        []string `json:"choices,omitempty"`
+	Size           int      `json:"size,omitempty"`
+}
+
+// MergeField defines a merge field of a list.
+type MergeField struct {
+	MergeID      int                `json:"merge_id"`
+	Tag          string             `json:"tag"`
+	Name         string             `json:"name"`
+	Type         string             `json:"type"`
+	Required     bool               `json:"required"`
+	DefaultValue string             `json:"default_value,omitempty"`
+	Public       bool               `json:"public"`
+	DisplayOrder int                `json:"display_order,omitempty"`
+	Options      *MergeFieldOptions `json:"options,omitempty"`
+	HelpText     string             `json:"help_text,omitempty"`
+	ListID       string             `json:"list_id"`
+}
+
+// MergeFields defines a list of merge fields.
+type MergeFields struct {
+	MergeFields []MergeField `json:"merge_fields,omitempty"`
+	ListID      string       `json:"list_id"`
+	TotalItems  int          `json:"total_items"`
+}
+
+// MaxMergeFieldCount is the maximum number of merge fields the API
+// returns per request. Lists hold far fewer merge fields than this, so
+// requesting this count retrieves every merge field of a list at once.
+const MaxMergeFieldCount = 1000
+
+// GetMergeFieldsParams defines the available parameters that can be
+// used when getting the merge fields of a list via the GetMergeFields
+// function.
+type GetMergeFieldsParams struct {
+	Fields        []string `url:"fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+	Count         int      `url:"count,omitempty"`
+	Offset        int      `url:"offset,omitempty"`
+	Type          string   `url:"type,omitempty"`
+	Required      bool     `url:"required,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// GetMergeFieldsParams object.
+func (gmfp *GetMergeFieldsParams) EncodeQueryString(v interface{}) (string, error) {
+	return query.Encode(struct {
+		Fields        string `url:"fields,omitempty"`
+		ExcludeFields string `url:"exclude_fields,omitempty"`
+		Count         int    `url:"count,omitempty"`
+		Offset        int    `url:"offset,omitempty"`
+		Type          string `url:"type,omitempty"`
+		Required      bool   `url:"required,omitempty"`
+	}{
+		Fields:        strings.Join(gmfp.Fields, ","),
+		ExcludeFields: strings.Join(gmfp.ExcludeFields, ","),
+		Count:         gmfp.Count,
+		Offset:        gmfp.Offset,
+		Type:          gmfp.Type,
+		Required:      gmfp.Required,
+	})
+}
+
+// GetMergeFields retrieves the merge fields of a list.
+func GetMergeFields(listID string, params *GetMergeFieldsParams) (*MergeFields, error) {
+	res := &MergeFields{}
+	path := fmt.Sprintf("lists/%s/merge-fields", listID)
+
+	if params == nil {
+		if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", path, params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
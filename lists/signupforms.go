@@ -0,0 +1,125 @@
+package lists
+
+import (
+	"fmt"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+)
+
+// SignupFormHeader defines the header of a signup form.
+type SignupFormHeader struct {
+	ImageURL         string `json:"image_url,omitempty"`
+	Text             string `json:"text,omitempty"`
+	ImageWidth       string `json:"image_width,omitempty"`
+	ImageHeight      string `json:"image_height,omitempty"`
+	ImageAlt         string `json:"image_alt,omitempty"`
+	ImageLink        string `json:"image_link,omitempty"`
+	ImageAlign       string `json:"image_align,omitempty"`
+	ImageBorderWidth string `json:"image_border_width,omitempty"`
+	ImageBorderStyle string `json:"image_border_style,omitempty"`
+	ImageBorderColor string `json:"image_border_color,omitempty"`
+	ImageTarget      string `json:"image_target,omitempty"`
+}
+
+// SignupFormSection defines a content section of a signup form.
+type SignupFormSection string
+
+// The signup form section definitions.
+const (
+	SignupFormSectionSignupMessage       SignupFormSection = "signup_message"
+	SignupFormSectionUnsubMessage        SignupFormSection = "unsub_message"
+	SignupFormSectionSignupThankYouTitle SignupFormSection = "signup_thank_you_title"
+)
+
+// SignupFormContent defines the content of a signup form section.
+type SignupFormContent struct {
+	Section SignupFormSection `json:"section"`
+	Value   string            `json:"value"`
+}
+
+// SignupFormSelector defines an element of a signup form that can be
+// styled.
+type SignupFormSelector string
+
+// The signup form selector definitions.
+const (
+	SignupFormSelectorPageBackground      SignupFormSelector = "page_background"
+	SignupFormSelectorPageHeader          SignupFormSelector = "page_header"
+	SignupFormSelectorPageOuterWrapper    SignupFormSelector = "page_outer_wrapper"
+	SignupFormSelectorBodyLinkStyle       SignupFormSelector = "body_link_style"
+	SignupFormSelectorFormsButtons        SignupFormSelector = "forms_buttons"
+	SignupFormSelectorFormsButtonsHovered SignupFormSelector = "forms_buttons_hovered"
+	SignupFormSelectorFormsFieldLabel     SignupFormSelector = "forms_field_label"
+	SignupFormSelectorFormsFieldText      SignupFormSelector = "forms_field_text"
+	SignupFormSelectorFormsRequired       SignupFormSelector = "forms_required"
+	SignupFormSelectorFormsRequiredLegend SignupFormSelector = "forms_required_legend"
+	SignupFormSelectorFormsHelpText       SignupFormSelector = "forms_help_text"
+	SignupFormSelectorFormsErrors         SignupFormSelector = "forms_errors"
+	SignupFormSelectorMonkeyRewardsBadge  SignupFormSelector = "monkey_rewards_badge"
+)
+
+// SignupFormStyleOption defines a CSS property of a signup form style.
+type SignupFormStyleOption struct {
+	Property string `json:"property"`
+	Value    string `json:"value"`
+}
+
+// SignupFormStyle defines the style of a signup form element.
+type SignupFormStyle struct {
+	Selector SignupFormSelector      `json:"selector"`
+	Options  []SignupFormStyleOption `json:"options"`
+}
+
+// SignupForm defines a signup form of a list.
+type SignupForm struct {
+	Header        *SignupFormHeader   `json:"header,omitempty"`
+	Contents      []SignupFormContent `json:"contents,omitempty"`
+	Styles        []SignupFormStyle   `json:"styles,omitempty"`
+	SignupFormURL string              `json:"signup_form_url,omitempty"`
+	ListID        string              `json:"list_id"`
+}
+
+// SignupForms defines a list of signup forms.
+type SignupForms struct {
+	SignupForms []SignupForm `json:"signup_forms,omitempty"`
+	ListID      string       `json:"list_id"`
+	TotalItems  int          `json:"total_items"`
+}
+
+// CustomizeSignupFormParams defines the available parameters that can
+// be used when customizing the signup form of a list via the
+// CustomizeSignupForm function.
+type CustomizeSignupFormParams struct {
+	Header   *SignupFormHeader   `json:"header,omitempty"`
+	Contents []SignupFormContent `json:"contents,omitempty"`
+	Styles   []SignupFormStyle   `json:"styles,omitempty"`
+}
+
+// GetSignupForms retrieves the signup forms of a list.
+func GetSignupForms(listID string) (*SignupForms, error) {
+	res := &SignupForms{}
+	path := fmt.Sprintf("lists/%s/signup-forms", listID)
+
+	if err := mailchimp.Call("GET", path, nil, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CustomizeSignupForm customizes the signup form of a list.
+func CustomizeSignupForm(listID string, params *CustomizeSignupFormParams) (*SignupForm, error) {
+	res := &SignupForm{}
+	path := fmt.Sprintf("lists/%s/signup-forms", listID)
+
+	if params == nil {
+		if err := mailchimp.Call("POST", path, nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("POST", path, nil, params, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -42,6 +42,18 @@ func SetClient(client *http.Client) {
 	httpClient = client
 }
 
+// Bool returns a pointer to the given bool value. It is used to set
+// optional fields on request parameters.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// String returns a pointer to the given string value. It is used to
+// set optional fields on request parameters.
+func String(v string) *string {
+	return &v
+}
+
 // Call issues a request to the MailChimp API.
 func Call(method, path string, queryParams, bodyParams, v interface{}) error {
 	// Check if the API key has been set.
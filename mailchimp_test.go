@@ -11,3 +11,15 @@ func TestSetKey(t *testing.T) {
 		t.Errorf("Expected to get nil error, got %s", err.Error())
 	}
 }
+
+func TestBool(t *testing.T) {
+	if b := Bool(false); b == nil || *b != false {
+		t.Error("Expected Bool(false) to return a pointer to false")
+	}
+}
+
+func TestString(t *testing.T) {
+	if s := String(""); s == nil || *s != "" {
+		t.Error("Expected String(\"\") to return a pointer to an empty string")
+	}
+}
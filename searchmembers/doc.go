@@ -0,0 +1,5 @@
+// Package searchmembers implements the Search Members resource of the
+// MailChimp API v3.
+//
+// Reference: http://developer.mailchimp.com/documentation/mailchimp/reference/search-members/
+package searchmembers
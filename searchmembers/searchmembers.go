@@ -0,0 +1,64 @@
+package searchmembers
+
+import (
+	"strings"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+	"github.com/beeker1121/mailchimp-go/lists/members"
+	"github.com/beeker1121/mailchimp-go/query"
+)
+
+// Matches defines a set of members matching a search query.
+type Matches struct {
+	Members    []members.Member `json:"members,omitempty"`
+	TotalItems int              `json:"total_items"`
+}
+
+// Results defines the results of a member search.
+type Results struct {
+	ExactMatches *Matches `json:"exact_matches,omitempty"`
+	FullSearch   *Matches `json:"full_search,omitempty"`
+}
+
+// SearchParams defines the available parameters that can be used when
+// searching for members via the Search function.
+type SearchParams struct {
+	Fields        []string `url:"fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+	Query         string   `url:"query"`
+	ListID        string   `url:"list_id,omitempty"`
+}
+
+// EncodeQueryString handles custom query string encoding for the
+// SearchParams object.
+func (sp *SearchParams) EncodeQueryString(v interface{}) (string, error) {
+	return query.Encode(struct {
+		Fields        string `url:"fields,omitempty"`
+		ExcludeFields string `url:"exclude_fields,omitempty"`
+		Query         string `url:"query"`
+		ListID        string `url:"list_id,omitempty"`
+	}{
+		Fields:        strings.Join(sp.Fields, ","),
+		ExcludeFields: strings.Join(sp.ExcludeFields, ","),
+		Query:         sp.Query,
+		ListID:        sp.ListID,
+	})
+}
+
+// Search searches for members across all lists, or within a single
+// list if a list ID is given, by email address or name.
+func Search(params *SearchParams) (*Results, error) {
+	res := &Results{}
+
+	if params == nil {
+		if err := mailchimp.Call("GET", "search-members", nil, nil, res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	if err := mailchimp.Call("GET", "search-members", params, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
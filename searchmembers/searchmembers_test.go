@@ -0,0 +1,107 @@
+package searchmembers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+	"github.com/beeker1121/mailchimp-go/lists"
+	"github.com/beeker1121/mailchimp-go/lists/members"
+)
+
+func TestResultsUnmarshal(t *testing.T) {
+	data := []byte(`{
+		"exact_matches": {
+			"members": [{"email_address": "user@example.com"}],
+			"total_items": 1
+		},
+		"full_search": {
+			"members": [],
+			"total_items": 0
+		}
+	}`)
+
+	results := &Results{}
+	if err := json.NewDecoder(bytes.NewBuffer(data)).Decode(results); err != nil {
+		t.Error(err)
+	}
+
+	if results.ExactMatches.TotalItems != 1 {
+		t.Errorf("Expected results.ExactMatches.TotalItems to equal 1, got %d", results.ExactMatches.TotalItems)
+	}
+	if results.ExactMatches.Members[0].EmailAddress != "user@example.com" {
+		t.Errorf("Expected exact match email to equal \"user@example.com\", got %s", results.ExactMatches.Members[0].EmailAddress)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	list, err := createList()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	member, err := members.New(list.ID, &members.NewParams{
+		EmailAddress: "mailchimp-go-test@github.com",
+		Status:       members.StatusPending,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	results, err := Search(&SearchParams{
+		Query:  "mailchimp-go-test@github.com",
+		ListID: list.ID,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if results.ExactMatches.TotalItems != 1 {
+		t.Errorf("Expected results.ExactMatches.TotalItems to equal 1, got %d", results.ExactMatches.TotalItems)
+	}
+
+	if err = members.Delete(list.ID, member.ID); err != nil {
+		t.Error(err)
+	}
+	if err = lists.Delete(list.ID); err != nil {
+		t.Error(err)
+	}
+}
+
+func createList() (*lists.List, error) {
+	params := &lists.NewParams{
+		Name: "mailchimp-go Test List",
+		Contact: &lists.Contact{
+			Company:  "Acme Corp",
+			Address1: "123 Main St",
+			City:     "Chicago",
+			State:    "IL",
+			Zip:      "60613",
+			Country:  "United States",
+		},
+		PermissionReminder: "You opted to receive updates on Acme Corp",
+		CampaignDefaults: &lists.CampaignDefaults{
+			FromName:  "John Doe",
+			FromEmail: "newsletter@acmecorp.com",
+			Subject:   "Newsletter",
+			Language:  "en",
+		},
+		EmailTypeOption: false,
+		Visibility:      lists.VisibilityPublic,
+	}
+
+	return lists.New(params)
+}
+
+func TestMain(m *testing.M) {
+	if err := mailchimp.SetKey(os.Getenv("MAILCHIMP_API_KEY")); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	os.Exit(code)
+}